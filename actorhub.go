@@ -34,10 +34,18 @@
 // The SDK returns typed errors for different scenarios:
 //
 //   - AuthenticationError: Invalid or missing API key (401)
+//   - PaymentRequiredError: Plan or balance does not cover the request (402)
+//   - ForbiddenError: API key lacks a required scope (403)
 //   - RateLimitError: Rate limit exceeded (429)
 //   - ValidationError: Request validation failed (422)
 //   - NotFoundError: Resource not found (404)
+//   - ConflictError: Request conflicts with resource state (409)
+//   - RequestTooLargeError: Request body exceeds the size limit (413)
+//   - BiometricConsentRequiredError: Consent evidence missing (client-side)
+//   - MinorDetectedError: Potential minor detected in strict mode (client-side)
+//   - JobFailedError: Asynchronous job failed or was canceled
 //   - ServerError: Server error (5xx)
+//   - RequestError: No API response, e.g. a network failure
 //
 // Example:
 //
@@ -47,7 +55,7 @@
 //	    case *actorhub.AuthenticationError:
 //	        fmt.Println("Invalid API key")
 //	    case *actorhub.RateLimitError:
-//	        fmt.Printf("Rate limit exceeded, retry after %d seconds\n", e.RetryAfter)
+//	        fmt.Printf("Rate limit exceeded, retry after %v\n", e.RetryAfterDuration())
 //	    default:
 //	        fmt.Println("Error:", err)
 //	    }
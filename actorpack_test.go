@@ -0,0 +1,36 @@
+package actorhub
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestActorPackVersionPin(t *testing.T) {
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query().Get("version"))
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+	c := NewClient("key", WithBaseURL(srv.URL))
+	ctx := context.Background()
+
+	if _, err := c.GetActorPackDownload(ctx, "pack_1", &ActorPackDownloadRequest{Version: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CreateInferenceToken(ctx, "pack_1", "lic_1", time.Minute, 3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CreateInferenceToken(ctx, "pack_1", "lic_1", time.Minute, 0); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"3", "3", ""}
+	for i := range want {
+		if queries[i] != want[i] {
+			t.Errorf("request %d: version = %q, want %q", i, queries[i], want[i])
+		}
+	}
+}
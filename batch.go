@@ -0,0 +1,200 @@
+package actorhub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// MaxBatchSize is the largest number of items a batch request may hold.
+const MaxBatchSize = 500
+
+// BatchItem is the outcome of one item of a batch request.
+type BatchItem[T any] struct {
+	// Index is the item's position in the slice passed to the batch method.
+	Index int
+
+	// Result is set if the item succeeded.
+	Result *T
+
+	// Err is set if the item failed. It has the same types as errors from
+	// single requests, such as *ValidationError for an item rejected before
+	// the batch was sent.
+	Err error
+}
+
+// BatchSummary counts the outcomes of a batch request.
+type BatchSummary struct {
+	Total     int
+	Succeeded int
+	Failed    int
+}
+
+// BatchResult holds the per-item outcomes of a batch request, in request
+// order. A batch method returns an error only if the batch as a whole
+// failed; items that fail individually are reported here so the rest of the
+// batch still succeeds.
+type BatchResult[T any] struct {
+	Items   []BatchItem[T]
+	Summary BatchSummary
+}
+
+// Successes returns the results of the items that succeeded, in request
+// order.
+func (b *BatchResult[T]) Successes() []T {
+	results := make([]T, 0, b.Summary.Succeeded)
+	for _, item := range b.Items {
+		if item.Err == nil && item.Result != nil {
+			results = append(results, *item.Result)
+		}
+	}
+	return results
+}
+
+// Failures returns the items that failed, in request order.
+func (b *BatchResult[T]) Failures() []BatchItem[T] {
+	var failures []BatchItem[T]
+	for _, item := range b.Items {
+		if item.Err != nil {
+			failures = append(failures, item)
+		}
+	}
+	return failures
+}
+
+// batchItemResponse is one entry of a batch response. Index refers to the
+// position of the item in the request as sent.
+type batchItemResponse struct {
+	Index  int             `json:"index"`
+	Status int             `json:"status"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  json.RawMessage `json:"error,omitempty"`
+}
+
+// doBatch validates each request, sends the valid ones to path as a batch,
+// and assembles the per-item outcomes. check, if not nil, may fail an item
+// whose result the client rejects.
+func doBatch[Req any, T any](ctx context.Context, c *Client, path string, reqs []*Req, validate func(*Req) error, check func(*T) error) (*BatchResult[T], error) {
+	if len(reqs) == 0 {
+		return nil, NewValidationError("Must provide at least one item", nil, "")
+	}
+	if len(reqs) > MaxBatchSize {
+		return nil, NewValidationError(fmt.Sprintf("Batch may hold at most %d items, got %d", MaxBatchSize, len(reqs)), nil, "")
+	}
+
+	result := &BatchResult[T]{Items: make([]BatchItem[T], len(reqs))}
+	var sent []*Req
+	var sentIndex []int
+	for i, req := range reqs {
+		result.Items[i].Index = i
+		if req == nil {
+			result.Items[i].Err = NewValidationError("Must provide a request", nil, "")
+			continue
+		}
+		if err := validate(req); err != nil {
+			result.Items[i].Err = err
+			continue
+		}
+		sent = append(sent, req)
+		sentIndex = append(sentIndex, i)
+	}
+
+	if len(sent) > 0 {
+		var resp struct {
+			Results []batchItemResponse `json:"results"`
+		}
+		// Item errors carry the batch's request ID; keep any metadata
+		// destination the caller set.
+		md, _ := ctx.Value(responseMetadataKey{}).(*ResponseMetadata)
+		if md == nil {
+			md = &ResponseMetadata{}
+			ctx = WithResponseMetadata(ctx, md)
+		}
+		body := map[string]interface{}{"items": sent}
+		if err := c.doRequest(ctx, http.MethodPost, path, body, &resp); err != nil {
+			return nil, err
+		}
+
+		answered := make([]bool, len(sent))
+		for _, r := range resp.Results {
+			if r.Index < 0 || r.Index >= len(sent) || answered[r.Index] {
+				continue
+			}
+			answered[r.Index] = true
+			item := &result.Items[sentIndex[r.Index]]
+			if r.Status >= 400 {
+				// Item errors may echo request payloads, like error bodies.
+				item.Err = responseError(r.Status, nil, []byte(c.Redact(string(r.Error))), md.RequestID)
+				continue
+			}
+			var value T
+			if err := json.Unmarshal(r.Result, &value); err != nil {
+				item.Err = fmt.Errorf("failed to unmarshal batch item: %w", err)
+				continue
+			}
+			if check != nil {
+				if err := check(&value); err != nil {
+					item.Err = err
+					continue
+				}
+			}
+			item.Result = &value
+		}
+		for i, ok := range answered {
+			if !ok {
+				result.Items[sentIndex[i]].Err = fmt.Errorf("batch response has no result for item %d", sentIndex[i])
+			}
+		}
+	}
+
+	result.Summary.Total = len(reqs)
+	for _, item := range result.Items {
+		if item.Err != nil {
+			result.Summary.Failed++
+		} else {
+			result.Summary.Succeeded++
+		}
+	}
+	return result, nil
+}
+
+// VerifyBatch checks up to MaxBatchSize images for protected identities in
+// one request. Each image succeeds or fails on its own; see BatchResult.
+func (c *Client) VerifyBatch(ctx context.Context, reqs []*VerifyRequest) (*BatchResult[VerifyResponse], error) {
+	validate := func(req *VerifyRequest) error {
+		if err := req.Validate(); err != nil {
+			return err
+		}
+		if c.requireBiometricConsent && req.ConsentEvidenceID == "" {
+			return NewBiometricConsentRequiredError("")
+		}
+		return nil
+	}
+	check := func(result *VerifyResponse) error {
+		return checkMinors(c, result.Identities, result.RequestID)
+	}
+	return doBatch(ctx, c, "/api/v1/identity/verify/batch", reqs, validate, check)
+}
+
+// CheckConsentBatch checks consent for up to MaxBatchSize images in one
+// request. Each image succeeds or fails on its own; see BatchResult. Unlike
+// CheckConsent, it does not use the stale consent fallback.
+func (c *Client) CheckConsentBatch(ctx context.Context, reqs []*ConsentCheckRequest) (*BatchResult[ConsentCheckResponse], error) {
+	validate := func(req *ConsentCheckRequest) error {
+		if err := req.Validate(); err != nil {
+			return err
+		}
+		if err := c.validatePlatform(req.Platform, req.IntendedUse); err != nil {
+			return err
+		}
+		if c.requireBiometricConsent && req.ConsentEvidenceID == "" {
+			return NewBiometricConsentRequiredError("")
+		}
+		return nil
+	}
+	check := func(result *ConsentCheckResponse) error {
+		return checkMinors(c, result.Faces, result.RequestID)
+	}
+	return doBatch(ctx, c, "/api/v1/consent/check/batch", reqs, validate, check)
+}
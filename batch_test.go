@@ -0,0 +1,36 @@
+package actorhub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestBatchItemErrorRedactedWithRequestID(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-ID", "req_batch")
+		w.Write([]byte(`{"results":[{"index":0,"status":409,"error":{"detail":"duplicate image","image_base64":"c2VjcmV0"}}]}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", WithBaseURL(srv.URL), WithMaxRetries(1))
+	result, err := c.VerifyBatch(context.Background(), []*VerifyRequest{{ImageBase64: "c2VjcmV0"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	itemErr := result.Items[0].Err
+	var conflictErr *ConflictError
+	if !errors.As(itemErr, &conflictErr) {
+		t.Fatalf("item error = %v, want a ConflictError", itemErr)
+	}
+	if conflictErr.RequestID != "req_batch" {
+		t.Errorf("RequestID = %q, want req_batch", conflictErr.RequestID)
+	}
+	if strings.Contains(itemErr.Error(), "c2VjcmV0") || strings.Contains(fmt.Sprint(conflictErr.ResponseData), "c2VjcmV0") {
+		t.Errorf("item error leaks the payload: %v %v", itemErr, conflictErr.ResponseData)
+	}
+}
@@ -0,0 +1,331 @@
+// Package c2pa embeds and verifies ActorHub license information in generated
+// media as C2PA content credentials.
+//
+// AttachCredentials adds a manifest store containing a creation action, an
+// ActorHub license assertion, and a hard binding to the media bytes, signed
+// with ES256 (ECDSA P-256). Verify extracts the manifest again and checks
+// the signature, hashes, and signer trust. JPEG and PNG are supported.
+//
+//	signed, err := c2pa.AttachCredentials(imageBytes, *license,
+//	    c2pa.WithSigner(c2pa.Signer{Key: key, Certificates: chain}),
+//	    c2pa.WithConsentCheckID(consent.RequestID),
+//	)
+//
+//	creds, err := c2pa.Verify(bytes.NewReader(signed), c2pa.WithTrustRoots(roots))
+//	if err == nil && creds.Valid() && creds.License != nil {
+//	    fmt.Println("Generated under license", creds.License.LicenseID)
+//	}
+package c2pa
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	actorhub "github.com/actorhubai/actorhub-go"
+)
+
+// LicenseAssertionLabel is the label of the assertion recording the ActorHub license.
+const LicenseAssertionLabel = "ai.actorhub.license"
+
+const (
+	actionsAssertionLabel  = "c2pa.actions"
+	dataHashAssertionLabel = "c2pa.hash.data"
+	claimLabel             = "c2pa.claim"
+	signatureLabel         = "c2pa.signature"
+	assertionStoreLabel    = "c2pa.assertions"
+
+	// trainedAlgorithmicMedia is the IPTC digital source type for AI-generated media.
+	trainedAlgorithmicMedia = "http://cv.iptc.org/newscodes/digitalsourcetype/trainedAlgorithmicMedia"
+
+	// COSE header labels and algorithm identifiers.
+	coseHeaderAlg     = 1
+	coseHeaderX5Chain = 33
+	coseAlgES256      = -7
+	coseSign1Tag      = 18
+)
+
+var (
+	// ErrNoSigner is returned when AttachCredentials is called without WithSigner.
+	ErrNoSigner = errors.New("c2pa: no signer configured")
+
+	// ErrManifestExists is returned when the media already carries a manifest store.
+	ErrManifestExists = errors.New("c2pa: media already contains a manifest store")
+)
+
+// LicenseAssertion is the ActorHub license recorded in a manifest.
+type LicenseAssertion struct {
+	LicenseID      string               `json:"license_id"`
+	IdentityID     string               `json:"identity_id"`
+	IdentityName   string               `json:"identity_name,omitempty"`
+	LicenseType    actorhub.LicenseType `json:"license_type"`
+	UsageType      actorhub.UsageType   `json:"usage_type"`
+	ProjectName    string               `json:"project_name,omitempty"`
+	ExpiresAt      *time.Time           `json:"expires_at,omitempty"`
+	ConsentCheckID string               `json:"consent_check_id,omitempty"`
+	SignedAt       time.Time            `json:"signed_at"`
+}
+
+// Signer holds the key and certificate chain used to sign manifests.
+type Signer struct {
+	// Key must be an ECDSA P-256 private key.
+	Key crypto.Signer
+	// Certificates is the certificate chain for Key, leaf first.
+	Certificates []*x509.Certificate
+}
+
+// Option configures AttachCredentials and Verify.
+type Option func(*options)
+
+type options struct {
+	signer         *Signer
+	consentCheckID string
+	trustRoots     *x509.CertPool
+	now            func() time.Time
+}
+
+// WithSigner sets the key and certificate chain used to sign the manifest.
+func WithSigner(s Signer) Option {
+	return func(o *options) {
+		o.signer = &s
+	}
+}
+
+// WithConsentCheckID records the consent check request ID that cleared the generation.
+func WithConsentCheckID(id string) Option {
+	return func(o *options) {
+		o.consentCheckID = id
+	}
+}
+
+// AttachCredentials returns a copy of imageBytes with a signed C2PA manifest
+// recording the license embedded in it.
+func AttachCredentials(imageBytes []byte, license actorhub.LicenseResponse, opts ...Option) ([]byte, error) {
+	o := options{now: time.Now}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.signer == nil {
+		return nil, ErrNoSigner
+	}
+	if err := checkSigner(o.signer); err != nil {
+		return nil, err
+	}
+
+	format, err := detectFormat(imageBytes)
+	if err != nil {
+		return nil, err
+	}
+	offset, wrap, err := insertionPoint(imageBytes, format)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiresAt *time.Time
+	if license.ExpiresAt != nil {
+		expiresAt = &license.ExpiresAt.Time
+	}
+	assertion := LicenseAssertion{
+		LicenseID:      license.ID,
+		IdentityID:     license.IdentityID,
+		IdentityName:   license.IdentityName,
+		LicenseType:    license.LicenseType,
+		UsageType:      license.UsageType,
+		ProjectName:    license.ProjectName,
+		ExpiresAt:      expiresAt,
+		ConsentCheckID: o.consentCheckID,
+		SignedAt:       o.now().UTC(),
+	}
+	licenseJSON, err := json.Marshal(assertion)
+	if err != nil {
+		return nil, fmt.Errorf("c2pa: failed to marshal license assertion: %w", err)
+	}
+
+	// The embedded manifest is excluded from the hard binding, so the hash
+	// covers exactly the original bytes.
+	dataHash := sha256.Sum256(imageBytes)
+	manifestLabel, err := newURN()
+	if err != nil {
+		return nil, err
+	}
+	instanceID, err := newURN()
+	if err != nil {
+		return nil, err
+	}
+
+	// The exclusion length is part of the manifest, so rebuild until the
+	// wrapped size is stable.
+	var wrapped []byte
+	length := 0
+	for i := 0; i < 4; i++ {
+		store, err := buildManifestStore(manifestParams{
+			label:       manifestLabel,
+			instanceID:  instanceID,
+			format:      format,
+			licenseJSON: licenseJSON,
+			dataHash:    dataHash[:],
+			start:       offset,
+			length:      length,
+			signer:      o.signer,
+		})
+		if err != nil {
+			return nil, err
+		}
+		wrapped = wrap(store)
+		if len(wrapped) == length {
+			break
+		}
+		length = len(wrapped)
+	}
+	if len(wrapped) != length {
+		return nil, errors.New("c2pa: manifest size did not converge")
+	}
+
+	out := make([]byte, 0, len(imageBytes)+len(wrapped))
+	out = append(out, imageBytes[:offset]...)
+	out = append(out, wrapped...)
+	out = append(out, imageBytes[offset:]...)
+	return out, nil
+}
+
+type manifestParams struct {
+	label       string
+	instanceID  string
+	format      string
+	licenseJSON []byte
+	dataHash    []byte
+	start       int
+	length      int
+	signer      *Signer
+}
+
+// buildManifestStore encodes a JUMBF manifest store with a single manifest.
+func buildManifestStore(p manifestParams) ([]byte, error) {
+	actions := encodeCBOR(cborOrderedMap{
+		{"actions", []interface{}{
+			cborOrderedMap{
+				{"action", "c2pa.created"},
+				{"digitalSourceType", trainedAlgorithmicMedia},
+				{"softwareAgent", "actorhub-go/" + actorhub.Version},
+			},
+		}},
+	})
+	dataHash := encodeCBOR(cborOrderedMap{
+		{"exclusions", []interface{}{
+			cborOrderedMap{{"start", p.start}, {"length", p.length}},
+		}},
+		{"name", "jumbf manifest"},
+		{"alg", "sha256"},
+		{"hash", p.dataHash},
+		{"pad", []byte{}},
+	})
+
+	assertions := []struct {
+		label   string
+		payload []byte
+	}{
+		{actionsAssertionLabel, jumbfSuperboxPayload(uuidCBOR, actionsAssertionLabel, jumbfBox(boxTypeCBOR, actions))},
+		{LicenseAssertionLabel, jumbfSuperboxPayload(uuidJSON, LicenseAssertionLabel, jumbfBox(boxTypeJSON, p.licenseJSON))},
+		{dataHashAssertionLabel, jumbfSuperboxPayload(uuidCBOR, dataHashAssertionLabel, jumbfBox(boxTypeCBOR, dataHash))},
+	}
+
+	var refs []interface{}
+	var boxes [][]byte
+	for _, a := range assertions {
+		sum := sha256.Sum256(a.payload)
+		refs = append(refs, cborOrderedMap{
+			{"url", "self#jumbf=" + assertionStoreLabel + "/" + a.label},
+			{"hash", sum[:]},
+		})
+		boxes = append(boxes, jumbfBox(boxTypeSuperbox, a.payload))
+	}
+
+	claim := encodeCBOR(cborOrderedMap{
+		{"claim_generator", "actorhub-go/" + actorhub.Version},
+		{"signature", "self#jumbf=" + signatureLabel},
+		{"assertions", refs},
+		{"dc:format", p.format},
+		{"instanceID", "xmp:iid:" + p.instanceID},
+		{"alg", "sha256"},
+	})
+
+	signature, err := signClaim(p.signer, claim)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := jumbfSuperbox(uuidManifest, p.label,
+		jumbfSuperbox(uuidAssertionStore, assertionStoreLabel, boxes...),
+		jumbfSuperbox(uuidClaim, claimLabel, jumbfBox(boxTypeCBOR, claim)),
+		jumbfSuperbox(uuidSignature, signatureLabel, jumbfBox(boxTypeCBOR, signature)),
+	)
+	return jumbfSuperbox(uuidManifestStore, "c2pa", manifest), nil
+}
+
+// checkSigner verifies the signer holds an ECDSA P-256 key and a certificate.
+func checkSigner(s *Signer) error {
+	if s.Key == nil || len(s.Certificates) == 0 {
+		return errors.New("c2pa: signer requires a key and certificate chain")
+	}
+	pub, ok := s.Key.Public().(*ecdsa.PublicKey)
+	if !ok || pub.Curve != elliptic.P256() {
+		return errors.New("c2pa: signer key must be ECDSA P-256")
+	}
+	return nil
+}
+
+// signClaim produces a tagged COSE_Sign1 structure over the claim with a
+// detached payload.
+func signClaim(s *Signer, claim []byte) ([]byte, error) {
+	protected := encodeCBOR(cborOrderedMap{{coseHeaderAlg, coseAlgES256}})
+	digest := sha256.Sum256(sigStructure(protected, claim))
+
+	der, err := s.Key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("c2pa: failed to sign claim: %w", err)
+	}
+	var sig struct{ R, S *big.Int }
+	if _, err := asn1.Unmarshal(der, &sig); err != nil {
+		return nil, fmt.Errorf("c2pa: failed to parse signature: %w", err)
+	}
+	raw := make([]byte, 64)
+	sig.R.FillBytes(raw[:32])
+	sig.S.FillBytes(raw[32:])
+
+	chain := make([]interface{}, len(s.Certificates))
+	for i, cert := range s.Certificates {
+		chain[i] = cert.Raw
+	}
+
+	return encodeCBOR(cborTag{coseSign1Tag, []interface{}{
+		protected,
+		cborOrderedMap{{coseHeaderX5Chain, chain}},
+		nil,
+		raw,
+	}}), nil
+}
+
+// sigStructure builds the COSE Sig_structure for a Sign1 signature.
+func sigStructure(protected, payload []byte) []byte {
+	return encodeCBOR([]interface{}{"Signature1", protected, []byte{}, payload})
+}
+
+// newURN returns a random version 4 UUID URN.
+func newURN() (string, error) {
+	var u [16]byte
+	if _, err := rand.Read(u[:]); err != nil {
+		return "", fmt.Errorf("c2pa: failed to generate UUID: %w", err)
+	}
+	u[6] = u[6]&0x0f | 0x40
+	u[8] = u[8]&0x3f | 0x80
+	return fmt.Sprintf("urn:uuid:%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:16]), nil
+}
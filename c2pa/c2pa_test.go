@@ -0,0 +1,154 @@
+package c2pa
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"image"
+	"image/png"
+	"math/big"
+	"testing"
+	"time"
+
+	actorhub "github.com/actorhubai/actorhub-go"
+)
+
+// testSigner returns a signer with a self-signed certificate and a pool
+// trusting it.
+func testSigner(t *testing.T) (Signer, *x509.CertPool) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test signer"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+	return Signer{Key: key, Certificates: []*x509.Certificate{cert}}, roots
+}
+
+func testPNG(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, 8, 8))); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestAttachAndVerify(t *testing.T) {
+	signer, roots := testSigner(t)
+	license := actorhub.LicenseResponse{ID: "lic_1", IdentityID: "id_1"}
+	signed, err := AttachCredentials(testPNG(t), license, WithSigner(signer), WithConsentCheckID("req_1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	creds, err := Verify(bytes.NewReader(signed), WithTrustRoots(roots))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !creds.Valid() {
+		t.Fatalf("credentials not valid: %v", creds.Problems)
+	}
+	if creds.License == nil || creds.License.LicenseID != "lic_1" || creds.License.ConsentCheckID != "req_1" {
+		t.Errorf("License = %+v, want lic_1 cleared by req_1", creds.License)
+	}
+
+	if _, err := AttachCredentials(signed, license, WithSigner(signer)); !errors.Is(err, ErrManifestExists) {
+		t.Errorf("second attach: err = %v, want ErrManifestExists", err)
+	}
+
+	untrusted, err := Verify(bytes.NewReader(signed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if untrusted.Trusted || !untrusted.SignatureValid {
+		t.Errorf("without trust roots: Trusted = %v, SignatureValid = %v", untrusted.Trusted, untrusted.SignatureValid)
+	}
+}
+
+func TestVerifyDetectsEditedMedia(t *testing.T) {
+	signer, roots := testSigner(t)
+	signed, err := AttachCredentials(testPNG(t), actorhub.LicenseResponse{ID: "lic_1"}, WithSigner(signer))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Flip a byte of the image data after the manifest, in the IEND CRC.
+	edited := append([]byte(nil), signed...)
+	edited[len(edited)-1] ^= 0xff
+	creds, err := Verify(bytes.NewReader(edited), WithTrustRoots(roots))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.ContentValid || creds.Valid() {
+		t.Error("edited media verified as intact")
+	}
+}
+
+func TestVerifyWithoutManifest(t *testing.T) {
+	if _, err := Verify(bytes.NewReader(testPNG(t))); !errors.Is(err, ErrNoManifest) {
+		t.Errorf("err = %v, want ErrNoManifest", err)
+	}
+}
+
+func TestCBORRoundTrip(t *testing.T) {
+	in := cborOrderedMap{
+		{"label", "c2pa.claim"},
+		{int64(-7), []byte{1, 2, 3}},
+		{"list", []interface{}{int64(1), "two", true}},
+		{"tagged", cborTag{number: coseSign1Tag, value: int64(300)}},
+	}
+	out, err := decodeCBOR(encodeCBOR(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, ok := out.(cborOrderedMap)
+	if !ok || m.getString("label") != "c2pa.claim" {
+		t.Fatalf("decoded %#v", out)
+	}
+	if v, _ := m.get(-7); !bytes.Equal(v.([]byte), []byte{1, 2, 3}) {
+		t.Errorf("integer key: got %v", v)
+	}
+	if v, _ := m.get("tagged"); v.(cborTag).number != coseSign1Tag || v.(cborTag).value != int64(300) {
+		t.Errorf("tag: got %#v", v)
+	}
+}
+
+func TestCBORRejectsMalformedInput(t *testing.T) {
+	nested := bytes.Repeat([]byte{0x81}, maxCBORDepth+2) // arrays of one array ...
+	nested = append(nested, 0x00)
+	inputs := map[string][]byte{
+		"empty":          {},
+		"truncated text": {0x65, 'a', 'b'},
+		"huge array":     {0x9b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		"trailing data":  {0x01, 0x02},
+		"too deep":       nested,
+	}
+	for name, data := range inputs {
+		if _, err := decodeCBOR(data); !errors.Is(err, errCBOR) {
+			t.Errorf("%s: err = %v, want errCBOR", name, err)
+		}
+	}
+}
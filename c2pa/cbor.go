@@ -0,0 +1,289 @@
+package c2pa
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// CBOR major types used by C2PA claims and COSE signatures.
+const (
+	cborUint   = 0
+	cborNegint = 1
+	cborBytes  = 2
+	cborText   = 3
+	cborArray  = 4
+	cborMap    = 5
+	cborTagged = 6
+	cborSimple = 7
+)
+
+// cborPair is a single key/value entry of an ordered CBOR map.
+type cborPair struct {
+	key   interface{}
+	value interface{}
+}
+
+// cborOrderedMap is a CBOR map that preserves key order so encoding is
+// deterministic.
+type cborOrderedMap []cborPair
+
+// cborTag is a tagged CBOR data item.
+type cborTag struct {
+	number uint64
+	value  interface{}
+}
+
+// encodeCBOR encodes v, which must be built from nil, bool, int, int64,
+// uint64, string, []byte, []interface{}, cborOrderedMap, and cborTag values.
+func encodeCBOR(v interface{}) []byte {
+	var b bytes.Buffer
+	writeCBOR(&b, v)
+	return b.Bytes()
+}
+
+func writeCBOR(b *bytes.Buffer, v interface{}) {
+	switch v := v.(type) {
+	case nil:
+		b.WriteByte(cborSimple<<5 | 22)
+	case bool:
+		if v {
+			b.WriteByte(cborSimple<<5 | 21)
+		} else {
+			b.WriteByte(cborSimple<<5 | 20)
+		}
+	case int:
+		writeCBORInt(b, int64(v))
+	case int64:
+		writeCBORInt(b, v)
+	case uint64:
+		writeCBORHead(b, cborUint, v)
+	case string:
+		writeCBORHead(b, cborText, uint64(len(v)))
+		b.WriteString(v)
+	case []byte:
+		writeCBORHead(b, cborBytes, uint64(len(v)))
+		b.Write(v)
+	case []interface{}:
+		writeCBORHead(b, cborArray, uint64(len(v)))
+		for _, item := range v {
+			writeCBOR(b, item)
+		}
+	case cborOrderedMap:
+		writeCBORHead(b, cborMap, uint64(len(v)))
+		for _, pair := range v {
+			writeCBOR(b, pair.key)
+			writeCBOR(b, pair.value)
+		}
+	case cborTag:
+		writeCBORHead(b, cborTagged, v.number)
+		writeCBOR(b, v.value)
+	default:
+		panic(fmt.Sprintf("c2pa: unsupported CBOR type %T", v))
+	}
+}
+
+func writeCBORInt(b *bytes.Buffer, v int64) {
+	if v < 0 {
+		writeCBORHead(b, cborNegint, uint64(-1-v))
+		return
+	}
+	writeCBORHead(b, cborUint, uint64(v))
+}
+
+func writeCBORHead(b *bytes.Buffer, major byte, n uint64) {
+	m := major << 5
+	switch {
+	case n < 24:
+		b.WriteByte(m | byte(n))
+	case n <= 0xff:
+		b.WriteByte(m | 24)
+		b.WriteByte(byte(n))
+	case n <= 0xffff:
+		b.WriteByte(m | 25)
+		binary.Write(b, binary.BigEndian, uint16(n))
+	case n <= 0xffffffff:
+		b.WriteByte(m | 26)
+		binary.Write(b, binary.BigEndian, uint32(n))
+	default:
+		b.WriteByte(m | 27)
+		binary.Write(b, binary.BigEndian, n)
+	}
+}
+
+var errCBOR = errors.New("c2pa: malformed CBOR")
+
+// decodeCBOR decodes a single data item from data. Integers decode to int64,
+// maps to cborOrderedMap, and tags to cborTag. Indefinite-length items are
+// not supported.
+func decodeCBOR(data []byte) (interface{}, error) {
+	v, rest, err := readCBOR(data, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, errCBOR
+	}
+	return v, nil
+}
+
+// maxCBORDepth bounds nesting to protect against hostile input.
+const maxCBORDepth = 32
+
+func readCBOR(data []byte, depth int) (interface{}, []byte, error) {
+	if len(data) == 0 || depth > maxCBORDepth {
+		return nil, nil, errCBOR
+	}
+	major, info := data[0]>>5, data[0]&0x1f
+	data = data[1:]
+
+	if major == cborSimple {
+		switch info {
+		case 20:
+			return false, data, nil
+		case 21:
+			return true, data, nil
+		case 22, 23:
+			return nil, data, nil
+		case 25:
+			if len(data) < 2 {
+				return nil, nil, errCBOR
+			}
+			return float64(halfToFloat32(binary.BigEndian.Uint16(data))), data[2:], nil
+		case 26:
+			if len(data) < 4 {
+				return nil, nil, errCBOR
+			}
+			return float64(math.Float32frombits(binary.BigEndian.Uint32(data))), data[4:], nil
+		case 27:
+			if len(data) < 8 {
+				return nil, nil, errCBOR
+			}
+			return math.Float64frombits(binary.BigEndian.Uint64(data)), data[8:], nil
+		default:
+			return nil, nil, errCBOR
+		}
+	}
+
+	var n uint64
+	switch {
+	case info < 24:
+		n = uint64(info)
+	case info == 24 && len(data) >= 1:
+		n, data = uint64(data[0]), data[1:]
+	case info == 25 && len(data) >= 2:
+		n, data = uint64(binary.BigEndian.Uint16(data)), data[2:]
+	case info == 26 && len(data) >= 4:
+		n, data = uint64(binary.BigEndian.Uint32(data)), data[4:]
+	case info == 27 && len(data) >= 8:
+		n, data = binary.BigEndian.Uint64(data), data[8:]
+	default:
+		return nil, nil, errCBOR
+	}
+
+	switch major {
+	case cborUint:
+		if n > math.MaxInt64 {
+			return n, data, nil
+		}
+		return int64(n), data, nil
+	case cborNegint:
+		if n > math.MaxInt64 {
+			return nil, nil, errCBOR
+		}
+		return -1 - int64(n), data, nil
+	case cborBytes, cborText:
+		if n > uint64(len(data)) {
+			return nil, nil, errCBOR
+		}
+		if major == cborText {
+			return string(data[:n]), data[n:], nil
+		}
+		return data[:n], data[n:], nil
+	case cborArray:
+		if n > uint64(len(data)) {
+			return nil, nil, errCBOR
+		}
+		items := make([]interface{}, 0, n)
+		for i := uint64(0); i < n; i++ {
+			var item interface{}
+			var err error
+			if item, data, err = readCBOR(data, depth+1); err != nil {
+				return nil, nil, err
+			}
+			items = append(items, item)
+		}
+		return items, data, nil
+	case cborMap:
+		if n > uint64(len(data)) {
+			return nil, nil, errCBOR
+		}
+		m := make(cborOrderedMap, 0, n)
+		for i := uint64(0); i < n; i++ {
+			var pair cborPair
+			var err error
+			if pair.key, data, err = readCBOR(data, depth+1); err != nil {
+				return nil, nil, err
+			}
+			if pair.value, data, err = readCBOR(data, depth+1); err != nil {
+				return nil, nil, err
+			}
+			m = append(m, pair)
+		}
+		return m, data, nil
+	default: // cborTagged
+		value, rest, err := readCBOR(data, depth+1)
+		if err != nil {
+			return nil, nil, err
+		}
+		return cborTag{number: n, value: value}, rest, nil
+	}
+}
+
+// get returns the value for key. Integer keys match regardless of Go type.
+func (m cborOrderedMap) get(key interface{}) (interface{}, bool) {
+	if k, ok := key.(int); ok {
+		key = int64(k)
+	}
+	for _, pair := range m {
+		if pair.key == key {
+			return pair.value, true
+		}
+	}
+	return nil, false
+}
+
+// getString returns the text value for key, or "" if absent.
+func (m cborOrderedMap) getString(key string) string {
+	v, _ := m.get(key)
+	s, _ := v.(string)
+	return s
+}
+
+// getBytes returns the byte string value for key, or nil if absent.
+func (m cborOrderedMap) getBytes(key string) []byte {
+	v, _ := m.get(key)
+	b, _ := v.([]byte)
+	return b
+}
+
+// halfToFloat32 converts an IEEE 754 half-precision value.
+func halfToFloat32(h uint16) float32 {
+	sign := uint32(h>>15) << 31
+	exp := uint32(h>>10) & 0x1f
+	frac := uint32(h) & 0x3ff
+	switch exp {
+	case 0:
+		f := float32(frac) / (1 << 24)
+		if sign != 0 {
+			return -f
+		}
+		return f
+	case 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | frac<<13)
+	default:
+		return math.Float32frombits(sign | (exp+112)<<23 | frac<<13)
+	}
+}
@@ -0,0 +1,249 @@
+package c2pa
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+)
+
+// Media types supported for embedding and extracting manifests.
+const (
+	formatJPEG = "image/jpeg"
+	formatPNG  = "image/png"
+)
+
+var pngSignature = []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n'}
+
+// ErrUnsupportedFormat is returned for media other than JPEG and PNG.
+var ErrUnsupportedFormat = errors.New("c2pa: unsupported media format")
+
+var errMalformed = errors.New("c2pa: malformed media")
+
+// detectFormat returns the media type of data from its magic bytes.
+func detectFormat(data []byte) (string, error) {
+	switch {
+	case len(data) >= 3 && data[0] == 0xFF && data[1] == 0xD8 && data[2] == 0xFF:
+		return formatJPEG, nil
+	case bytes.HasPrefix(data, pngSignature):
+		return formatPNG, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// jpegSegment is a marker segment in the JPEG header.
+type jpegSegment struct {
+	marker  byte
+	offset  int // offset of the 0xFF marker prefix
+	length  int // total length including marker and length field
+	payload []byte
+}
+
+// jpegHeaderSegments returns the marker segments preceding the image scan.
+func jpegHeaderSegments(data []byte) ([]jpegSegment, error) {
+	var segments []jpegSegment
+	pos := 2 // skip SOI
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return nil, errMalformed
+		}
+		marker := data[pos+1]
+		if marker == 0xFF { // fill byte
+			pos++
+			continue
+		}
+		if marker == 0xDA || marker == 0xD9 { // SOS or EOI
+			return segments, nil
+		}
+		if marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7) {
+			pos += 2
+			continue
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		if length < 2 || pos+2+length > len(data) {
+			return nil, errMalformed
+		}
+		segments = append(segments, jpegSegment{
+			marker:  marker,
+			offset:  pos,
+			length:  2 + length,
+			payload: data[pos+4 : pos+2+length],
+		})
+		pos += 2 + length
+	}
+	return nil, errMalformed
+}
+
+// isJPEGManifestSegment reports whether an APP11 segment carries C2PA JUMBF data.
+func isJPEGManifestSegment(s jpegSegment) bool {
+	return s.marker == 0xEB && len(s.payload) >= 16 && s.payload[0] == 'J' && s.payload[1] == 'P' &&
+		string(s.payload[12:16]) == boxTypeSuperbox
+}
+
+// jpegInsertOffset returns where manifest segments are inserted: after SOI and
+// a leading JFIF APP0 segment, if any.
+func jpegInsertOffset(segments []jpegSegment) int {
+	if len(segments) > 0 && segments[0].marker == 0xE0 && segments[0].offset == 2 {
+		return 2 + segments[0].length
+	}
+	return 2
+}
+
+// maxAPP11Data is the JUMBF payload carried by one APP11 segment after the
+// length, CI, En, Z, LBox, and TBox fields.
+const maxAPP11Data = 0xFFFF - 2 - 2 - 2 - 4 - 8
+
+// jpegWrapManifest splits a JUMBF manifest store into APP11 segments.
+func jpegWrapManifest(store []byte) []byte {
+	header, body := store[:8], store[8:]
+	var b bytes.Buffer
+	for seq := uint32(1); ; seq++ {
+		n := len(body)
+		if n > maxAPP11Data {
+			n = maxAPP11Data
+		}
+		b.Write([]byte{0xFF, 0xEB})
+		binary.Write(&b, binary.BigEndian, uint16(2+2+2+4+8+n))
+		b.Write([]byte{'J', 'P'})
+		binary.Write(&b, binary.BigEndian, uint16(1)) // box instance number
+		binary.Write(&b, binary.BigEndian, seq)
+		b.Write(header)
+		b.Write(body[:n])
+		body = body[n:]
+		if len(body) == 0 {
+			return b.Bytes()
+		}
+	}
+}
+
+// pngChunk is a chunk in a PNG stream.
+type pngChunk struct {
+	typ    string
+	offset int // offset of the length field
+	length int // total length including length, type, and CRC fields
+	data   []byte
+}
+
+// pngChunks returns the chunks of a PNG stream.
+func pngChunks(data []byte) ([]pngChunk, error) {
+	var chunks []pngChunk
+	pos := len(pngSignature)
+	for pos+12 <= len(data) {
+		n := int(binary.BigEndian.Uint32(data[pos:]))
+		if n < 0 || pos+12+n > len(data) {
+			return nil, errMalformed
+		}
+		chunk := pngChunk{
+			typ:    string(data[pos+4 : pos+8]),
+			offset: pos,
+			length: 12 + n,
+			data:   data[pos+8 : pos+8+n],
+		}
+		chunks = append(chunks, chunk)
+		pos += chunk.length
+		if chunk.typ == "IEND" {
+			return chunks, nil
+		}
+	}
+	return nil, errMalformed
+}
+
+// pngInsertOffset returns where the manifest chunk is inserted: directly
+// after the IHDR chunk.
+func pngInsertOffset(chunks []pngChunk) (int, error) {
+	if len(chunks) == 0 || chunks[0].typ != "IHDR" {
+		return 0, errMalformed
+	}
+	return chunks[0].offset + chunks[0].length, nil
+}
+
+// pngWrapManifest wraps a JUMBF manifest store into a caBX chunk.
+func pngWrapManifest(store []byte) []byte {
+	var b bytes.Buffer
+	binary.Write(&b, binary.BigEndian, uint32(len(store)))
+	b.WriteString("caBX")
+	b.Write(store)
+	binary.Write(&b, binary.BigEndian, crc32.ChecksumIEEE(b.Bytes()[4:]))
+	return b.Bytes()
+}
+
+// insertionPoint locates where a manifest is embedded in data and returns
+// the function that wraps a manifest store for that format.
+func insertionPoint(data []byte, format string) (int, func([]byte) []byte, error) {
+	switch format {
+	case formatJPEG:
+		segments, err := jpegHeaderSegments(data)
+		if err != nil {
+			return 0, nil, err
+		}
+		for _, s := range segments {
+			if isJPEGManifestSegment(s) {
+				return 0, nil, ErrManifestExists
+			}
+		}
+		return jpegInsertOffset(segments), jpegWrapManifest, nil
+	case formatPNG:
+		chunks, err := pngChunks(data)
+		if err != nil {
+			return 0, nil, err
+		}
+		for _, c := range chunks {
+			if c.typ == "caBX" {
+				return 0, nil, ErrManifestExists
+			}
+		}
+		offset, err := pngInsertOffset(chunks)
+		return offset, pngWrapManifest, err
+	default:
+		return 0, nil, ErrUnsupportedFormat
+	}
+}
+
+// ErrNoManifest is returned when media carries no C2PA manifest store.
+var ErrNoManifest = errors.New("c2pa: no manifest store found")
+
+// extractManifest returns the embedded JUMBF manifest store and the byte
+// range it occupies in data.
+func extractManifest(data []byte, format string) (store []byte, start, length int, err error) {
+	switch format {
+	case formatJPEG:
+		segments, err := jpegHeaderSegments(data)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		var b bytes.Buffer
+		end := -1
+		for _, s := range segments {
+			if !isJPEGManifestSegment(s) {
+				continue
+			}
+			if end >= 0 && s.offset != end {
+				return nil, 0, 0, errMalformed // manifest segments must be contiguous
+			}
+			if end < 0 {
+				start = s.offset
+				b.Write(s.payload[8:16]) // LBox and TBox
+			}
+			b.Write(s.payload[16:])
+			end = s.offset + s.length
+		}
+		if end < 0 {
+			return nil, 0, 0, ErrNoManifest
+		}
+		return b.Bytes(), start, end - start, nil
+	case formatPNG:
+		chunks, err := pngChunks(data)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		for _, c := range chunks {
+			if c.typ == "caBX" {
+				return c.data, c.offset, c.length, nil
+			}
+		}
+		return nil, 0, 0, ErrNoManifest
+	default:
+		return nil, 0, 0, ErrUnsupportedFormat
+	}
+}
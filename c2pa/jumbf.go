@@ -0,0 +1,176 @@
+package c2pa
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+// JUMBF superbox and content box types.
+const (
+	boxTypeSuperbox    = "jumb"
+	boxTypeDescription = "jumd"
+	boxTypeCBOR        = "cbor"
+	boxTypeJSON        = "json"
+)
+
+// C2PA JUMBF content type UUIDs. Each is a four character code followed by
+// the common ISO suffix 0011-0010-8000-00AA00389B71.
+var (
+	uuidManifestStore  = c2paUUID("c2pa")
+	uuidManifest       = c2paUUID("c2ma")
+	uuidAssertionStore = c2paUUID("c2as")
+	uuidClaim          = c2paUUID("c2cl")
+	uuidSignature      = c2paUUID("c2cs")
+	uuidCBOR           = c2paUUID("cbor")
+	uuidJSON           = c2paUUID("json")
+)
+
+func c2paUUID(code string) [16]byte {
+	var u [16]byte
+	copy(u[:4], code)
+	copy(u[4:], []byte{0x00, 0x11, 0x00, 0x10, 0x80, 0x00, 0x00, 0xAA, 0x00, 0x38, 0x9B, 0x71})
+	return u
+}
+
+// jumbfBox encodes a single ISO BMFF style box.
+func jumbfBox(typ string, payload []byte) []byte {
+	var b bytes.Buffer
+	binary.Write(&b, binary.BigEndian, uint32(8+len(payload)))
+	b.WriteString(typ)
+	b.Write(payload)
+	return b.Bytes()
+}
+
+// jumbfDescription encodes a description box marked requestable with a label.
+func jumbfDescription(uuid [16]byte, label string) []byte {
+	payload := make([]byte, 0, 16+1+len(label)+1)
+	payload = append(payload, uuid[:]...)
+	payload = append(payload, 0x03) // requestable, label present
+	payload = append(payload, label...)
+	payload = append(payload, 0x00)
+	return jumbfBox(boxTypeDescription, payload)
+}
+
+// jumbfSuperboxPayload returns the payload of a labelled superbox: its
+// description box followed by its children.
+func jumbfSuperboxPayload(uuid [16]byte, label string, children ...[]byte) []byte {
+	payload := jumbfDescription(uuid, label)
+	for _, child := range children {
+		payload = append(payload, child...)
+	}
+	return payload
+}
+
+// jumbfSuperbox encodes a labelled superbox.
+func jumbfSuperbox(uuid [16]byte, label string, children ...[]byte) []byte {
+	return jumbfBox(boxTypeSuperbox, jumbfSuperboxPayload(uuid, label, children...))
+}
+
+var errJUMBF = errors.New("c2pa: malformed JUMBF")
+
+// rawBox is a parsed box header and payload.
+type rawBox struct {
+	typ     string
+	payload []byte
+}
+
+// parseBoxes splits data into consecutive boxes.
+func parseBoxes(data []byte) ([]rawBox, error) {
+	var boxes []rawBox
+	for len(data) > 0 {
+		if len(data) < 8 {
+			return nil, errJUMBF
+		}
+		size := uint64(binary.BigEndian.Uint32(data))
+		typ := string(data[4:8])
+		header := uint64(8)
+		switch size {
+		case 0:
+			size = uint64(len(data))
+		case 1:
+			if len(data) < 16 {
+				return nil, errJUMBF
+			}
+			size = binary.BigEndian.Uint64(data[8:])
+			header = 16
+		}
+		if size < header || size > uint64(len(data)) {
+			return nil, errJUMBF
+		}
+		boxes = append(boxes, rawBox{typ: typ, payload: data[header:size]})
+		data = data[size:]
+	}
+	return boxes, nil
+}
+
+// jumbfNode is a parsed JUMBF superbox.
+type jumbfNode struct {
+	uuid     [16]byte
+	label    string
+	payload  []byte   // description and child boxes, as hashed by C2PA
+	children []rawBox // boxes following the description box
+}
+
+// parseSuperbox parses the payload of a jumb box.
+func parseSuperbox(payload []byte) (*jumbfNode, error) {
+	boxes, err := parseBoxes(payload)
+	if err != nil {
+		return nil, err
+	}
+	if len(boxes) == 0 || boxes[0].typ != boxTypeDescription || len(boxes[0].payload) < 17 {
+		return nil, errJUMBF
+	}
+	desc := boxes[0].payload
+	node := &jumbfNode{payload: payload, children: boxes[1:]}
+	copy(node.uuid[:], desc[:16])
+	if desc[16]&0x02 != 0 {
+		label := desc[17:]
+		end := bytes.IndexByte(label, 0)
+		if end < 0 {
+			return nil, errJUMBF
+		}
+		node.label = string(label[:end])
+	}
+	return node, nil
+}
+
+// superboxes returns the child superboxes of n.
+func (n *jumbfNode) superboxes() ([]*jumbfNode, error) {
+	var nodes []*jumbfNode
+	for _, b := range n.children {
+		if b.typ != boxTypeSuperbox {
+			continue
+		}
+		child, err := parseSuperbox(b.payload)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, child)
+	}
+	return nodes, nil
+}
+
+// child returns the child superbox with the given label, or nil.
+func (n *jumbfNode) child(label string) (*jumbfNode, error) {
+	nodes, err := n.superboxes()
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range nodes {
+		if node.label == label {
+			return node, nil
+		}
+	}
+	return nil, nil
+}
+
+// content returns the payload of the first content box of the given type.
+func (n *jumbfNode) content(typ string) []byte {
+	for _, b := range n.children {
+		if b.typ == typ {
+			return b.payload
+		}
+	}
+	return nil
+}
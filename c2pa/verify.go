@@ -0,0 +1,310 @@
+package c2pa
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+)
+
+// Credentials are the ActorHub content credentials extracted from media.
+type Credentials struct {
+	// License is the recorded ActorHub license, or nil if the manifest was
+	// not issued for an ActorHub license.
+	License *LicenseAssertion
+	// ClaimGenerator identifies the software that created the manifest.
+	ClaimGenerator string
+	// Certificates is the signer's certificate chain, leaf first.
+	Certificates []*x509.Certificate
+	// SignatureValid reports whether the claim signature verifies against the
+	// leaf certificate.
+	SignatureValid bool
+	// ContentValid reports whether the assertion hashes and the hard binding
+	// to the media bytes match.
+	ContentValid bool
+	// Trusted reports whether the certificate chain verifies against the
+	// roots passed with WithTrustRoots.
+	Trusted bool
+	// Problems lists the reasons any check failed.
+	Problems []string
+}
+
+// Valid reports whether the credentials are intact and signed by a trusted signer.
+func (c *Credentials) Valid() bool {
+	return c.SignatureValid && c.ContentValid && c.Trusted
+}
+
+// WithTrustRoots sets the certificate pool used to decide whether the
+// manifest signer is trusted. Without it, Credentials.Trusted is always false.
+func WithTrustRoots(roots *x509.CertPool) Option {
+	return func(o *options) {
+		o.trustRoots = roots
+	}
+}
+
+// Verify extracts the active C2PA manifest from JPEG or PNG media and
+// validates its signature, assertion hashes, and binding to the media bytes.
+// Validation failures are reported on the returned Credentials; an error is
+// returned only when no manifest can be read.
+func Verify(r io.Reader, opts ...Option) (*Credentials, error) {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("c2pa: failed to read media: %w", err)
+	}
+	format, err := detectFormat(data)
+	if err != nil {
+		return nil, err
+	}
+	store, start, length, err := extractManifest(data, format)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := activeManifest(store)
+	if err != nil {
+		return nil, err
+	}
+	assertionStore, err := manifest.child(assertionStoreLabel)
+	if err != nil {
+		return nil, err
+	}
+	claimBox, err := manifest.child(claimLabel)
+	if err != nil {
+		return nil, err
+	}
+	signatureBox, err := manifest.child(signatureLabel)
+	if err != nil {
+		return nil, err
+	}
+	if assertionStore == nil || claimBox == nil || signatureBox == nil {
+		return nil, errJUMBF
+	}
+
+	claimBytes := claimBox.content(boxTypeCBOR)
+	claimValue, err := decodeCBOR(claimBytes)
+	if err != nil {
+		return nil, err
+	}
+	claim, ok := claimValue.(cborOrderedMap)
+	if !ok {
+		return nil, errCBOR
+	}
+
+	creds := &Credentials{ClaimGenerator: claim.getString("claim_generator")}
+
+	// Signature
+	certs, err := verifyClaimSignature(signatureBox.content(boxTypeCBOR), claimBytes)
+	creds.Certificates = certs
+	if err != nil {
+		creds.Problems = append(creds.Problems, err.Error())
+	} else {
+		creds.SignatureValid = true
+	}
+
+	// Trust
+	if len(certs) > 0 && o.trustRoots != nil {
+		intermediates := x509.NewCertPool()
+		for _, cert := range certs[1:] {
+			intermediates.AddCert(cert)
+		}
+		_, err := certs[0].Verify(x509.VerifyOptions{
+			Roots:         o.trustRoots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		})
+		if err != nil {
+			creds.Problems = append(creds.Problems, "untrusted signer: "+err.Error())
+		} else {
+			creds.Trusted = true
+		}
+	}
+
+	// Assertion hashes and hard binding
+	assertions, err := assertionStore.superboxes()
+	if err != nil {
+		return nil, err
+	}
+	byLabel := make(map[string]*jumbfNode, len(assertions))
+	for _, a := range assertions {
+		byLabel[a.label] = a
+	}
+	creds.ContentValid = true
+	fail := func(problem string) {
+		creds.ContentValid = false
+		creds.Problems = append(creds.Problems, problem)
+	}
+
+	refs, _ := claim.get("assertions")
+	refList, _ := refs.([]interface{})
+	referenced := make(map[string]bool, len(refList))
+	for _, ref := range refList {
+		m, _ := ref.(cborOrderedMap)
+		label := strings.TrimPrefix(m.getString("url"), "self#jumbf="+assertionStoreLabel+"/")
+		referenced[label] = true
+		a := byLabel[label]
+		if a == nil {
+			fail("missing assertion " + label)
+			continue
+		}
+		sum := sha256.Sum256(a.payload)
+		if !bytes.Equal(sum[:], m.getBytes("hash")) {
+			fail("hash mismatch for assertion " + label)
+		}
+	}
+
+	if !referenced[dataHashAssertionLabel] || byLabel[dataHashAssertionLabel] == nil {
+		fail("missing hard binding assertion")
+	} else if err := verifyDataHash(byLabel[dataHashAssertionLabel], data, start, length); err != nil {
+		fail(err.Error())
+	}
+
+	// License
+	if a := byLabel[LicenseAssertionLabel]; a != nil && referenced[LicenseAssertionLabel] {
+		var license LicenseAssertion
+		if err := json.Unmarshal(a.content(boxTypeJSON), &license); err != nil {
+			fail("invalid license assertion: " + err.Error())
+		} else {
+			creds.License = &license
+		}
+	}
+
+	return creds, nil
+}
+
+// activeManifest returns the last manifest in a manifest store.
+func activeManifest(store []byte) (*jumbfNode, error) {
+	boxes, err := parseBoxes(store)
+	if err != nil {
+		return nil, err
+	}
+	if len(boxes) != 1 || boxes[0].typ != boxTypeSuperbox {
+		return nil, errJUMBF
+	}
+	root, err := parseSuperbox(boxes[0].payload)
+	if err != nil {
+		return nil, err
+	}
+	if root.uuid != uuidManifestStore {
+		return nil, ErrNoManifest
+	}
+	manifests, err := root.superboxes()
+	if err != nil {
+		return nil, err
+	}
+	if len(manifests) == 0 {
+		return nil, ErrNoManifest
+	}
+	return manifests[len(manifests)-1], nil
+}
+
+// verifyClaimSignature checks a COSE_Sign1 ES256 signature over the claim and
+// returns the signer's certificate chain.
+func verifyClaimSignature(sign1, claim []byte) ([]*x509.Certificate, error) {
+	value, err := decodeCBOR(sign1)
+	if err != nil {
+		return nil, err
+	}
+	if tag, ok := value.(cborTag); ok && tag.number == coseSign1Tag {
+		value = tag.value
+	}
+	parts, ok := value.([]interface{})
+	if !ok || len(parts) != 4 {
+		return nil, errors.New("invalid COSE_Sign1 structure")
+	}
+	protected, _ := parts[0].([]byte)
+	unprotected, _ := parts[1].(cborOrderedMap)
+	signature, _ := parts[3].([]byte)
+
+	protectedValue, err := decodeCBOR(protected)
+	if err != nil {
+		return nil, err
+	}
+	protectedMap, _ := protectedValue.(cborOrderedMap)
+	if alg, _ := protectedMap.get(coseHeaderAlg); alg != int64(coseAlgES256) {
+		return nil, fmt.Errorf("unsupported signature algorithm %v", alg)
+	}
+
+	chainValue, ok := protectedMap.get(coseHeaderX5Chain)
+	if !ok {
+		chainValue, _ = unprotected.get(coseHeaderX5Chain)
+	}
+	var rawCerts [][]byte
+	switch v := chainValue.(type) {
+	case []byte:
+		rawCerts = [][]byte{v}
+	case []interface{}:
+		for _, item := range v {
+			if der, ok := item.([]byte); ok {
+				rawCerts = append(rawCerts, der)
+			}
+		}
+	}
+	if len(rawCerts) == 0 {
+		return nil, errors.New("missing signer certificate")
+	}
+	certs := make([]*x509.Certificate, 0, len(rawCerts))
+	for _, der := range rawCerts {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signer certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+
+	pub, ok := certs[0].PublicKey.(*ecdsa.PublicKey)
+	if !ok || pub.Curve != elliptic.P256() || len(signature) != 64 {
+		return certs, errors.New("signer key does not match ES256")
+	}
+	digest := sha256.Sum256(sigStructure(protected, claim))
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:])
+	if !ecdsa.Verify(pub, digest[:], r, s) {
+		return certs, errors.New("claim signature is invalid")
+	}
+	return certs, nil
+}
+
+// verifyDataHash checks the hard binding assertion against the media bytes
+// outside the embedded manifest.
+func verifyDataHash(assertion *jumbfNode, data []byte, start, length int) error {
+	value, err := decodeCBOR(assertion.content(boxTypeCBOR))
+	if err != nil {
+		return err
+	}
+	m, _ := value.(cborOrderedMap)
+	if alg := m.getString("alg"); alg != "" && alg != "sha256" {
+		return fmt.Errorf("unsupported hash algorithm %q", alg)
+	}
+
+	exclusions, _ := m.get("exclusions")
+	list, _ := exclusions.([]interface{})
+	if len(list) != 1 {
+		return errors.New("hard binding must exclude exactly the manifest")
+	}
+	ex, _ := list[0].(cborOrderedMap)
+	exStart, _ := ex.get("start")
+	exLength, _ := ex.get("length")
+	if exStart != int64(start) || exLength != int64(length) {
+		return errors.New("hard binding exclusion does not match the manifest location")
+	}
+
+	h := sha256.New()
+	h.Write(data[:start])
+	h.Write(data[start+length:])
+	if !bytes.Equal(h.Sum(nil), m.getBytes("hash")) {
+		return errors.New("media bytes do not match the hard binding")
+	}
+	return nil
+}
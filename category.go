@@ -0,0 +1,84 @@
+package actorhub
+
+import "strings"
+
+// ContentCategory is a normalized category of proposed use, matched against
+// an identity's blocked categories.
+type ContentCategory string
+
+const (
+	ContentCategoryAdult          ContentCategory = "adult"
+	ContentCategoryAlcohol        ContentCategory = "alcohol"
+	ContentCategoryTobacco        ContentCategory = "tobacco"
+	ContentCategoryGambling       ContentCategory = "gambling"
+	ContentCategoryWeapons        ContentCategory = "weapons"
+	ContentCategoryPolitical      ContentCategory = "political"
+	ContentCategoryReligious      ContentCategory = "religious"
+	ContentCategoryPharmaceutical ContentCategory = "pharmaceutical"
+	ContentCategoryViolence       ContentCategory = "violence"
+	ContentCategoryDating         ContentCategory = "dating"
+	ContentCategoryCrypto         ContentCategory = "cryptocurrency"
+)
+
+// contentCategoryAliases maps common free-text variants to taxonomy values.
+var contentCategoryAliases = map[string]ContentCategory{
+	"nsfw":           ContentCategoryAdult,
+	"sexual":         ContentCategoryAdult,
+	"explicit":       ContentCategoryAdult,
+	"pornography":    ContentCategoryAdult,
+	"liquor":         ContentCategoryAlcohol,
+	"beer":           ContentCategoryAlcohol,
+	"wine":           ContentCategoryAlcohol,
+	"spirits":        ContentCategoryAlcohol,
+	"smoking":        ContentCategoryTobacco,
+	"vaping":         ContentCategoryTobacco,
+	"cigarettes":     ContentCategoryTobacco,
+	"betting":        ContentCategoryGambling,
+	"casino":         ContentCategoryGambling,
+	"firearms":       ContentCategoryWeapons,
+	"guns":           ContentCategoryWeapons,
+	"weapon":         ContentCategoryWeapons,
+	"politics":       ContentCategoryPolitical,
+	"political_ads":  ContentCategoryPolitical,
+	"election":       ContentCategoryPolitical,
+	"religion":       ContentCategoryReligious,
+	"pharma":         ContentCategoryPharmaceutical,
+	"medical":        ContentCategoryPharmaceutical,
+	"drugs":          ContentCategoryPharmaceutical,
+	"violent":        ContentCategoryViolence,
+	"gore":           ContentCategoryViolence,
+	"crypto":         ContentCategoryCrypto,
+	"nft":            ContentCategoryCrypto,
+	"online_dating":  ContentCategoryDating,
+	"dating_apps":    ContentCategoryDating,
+	"adult_content":  ContentCategoryAdult,
+	"alcoholic":      ContentCategoryAlcohol,
+	"gambling_sites": ContentCategoryGambling,
+}
+
+// NormalizeContentCategory maps a free-text category such as "Politics" or
+// "beer" to its taxonomy value. Unrecognized values are returned lowercased
+// with spaces and hyphens replaced by underscores.
+func NormalizeContentCategory(category string) ContentCategory {
+	key := strings.ToLower(strings.TrimSpace(category))
+	key = strings.NewReplacer(" ", "_", "-", "_").Replace(key)
+	if alias, ok := contentCategoryAliases[key]; ok {
+		return alias
+	}
+	return ContentCategory(key)
+}
+
+// BlocksCategory reports whether the proposed category matches one of the
+// blocked categories after normalizing both.
+func (r ConsentRestrictions) BlocksCategory(category string) bool {
+	want := NormalizeContentCategory(category)
+	if want == "" {
+		return false
+	}
+	for _, blocked := range r.BlockedCategories {
+		if NormalizeContentCategory(blocked) == want {
+			return true
+		}
+	}
+	return false
+}
@@ -25,6 +25,9 @@ const (
 
 	// Version is the SDK version.
 	Version = "0.1.0"
+
+	// checkoutPollInterval is the delay between checkout session status checks.
+	checkoutPollInterval = 2 * time.Second
 )
 
 // Client is the ActorHub API client.
@@ -33,6 +36,35 @@ type Client struct {
 	baseURL    string
 	httpClient *http.Client
 	maxRetries int
+	rateLimit  *rateLimitTracker
+
+	maxElapsedTime time.Duration
+	retryBudget    *retryBudget
+	hedgeDelay     time.Duration
+	maxHedges      int
+
+	uploadTimeout     time.Duration
+	processingTimeout time.Duration
+	compressMinSize   int
+	decompressors     map[string]Decompressor
+	dial              DialFunc
+	dnsCacheTTL       time.Duration
+	userAgent         string
+	debug             *debugLog
+	metrics           MetricsCollector
+	stats             *statsTracker
+	failurePolicy     FailurePolicy
+	consentCache      *consentCache
+	httpCache         HTTPCache
+	signingSecret     []byte
+	encryption        *encryptionKeys
+	extraPlatforms    []Platform
+
+	verifyResponses         bool
+	jwks                    *jwksCache
+	requireBiometricConsent bool
+	minorProtection         bool
+	redactors               []Redactor
 }
 
 // ClientOption is a function that configures the client.
@@ -52,7 +84,9 @@ func WithTimeout(timeout time.Duration) ClientOption {
 	}
 }
 
-// WithMaxRetries sets the maximum number of retries.
+// WithMaxRetries sets the maximum number of retries. Rate-limited requests
+// wait as long as the server's Retry-After asks, up to a minute; a longer
+// wait is returned as a RateLimitError for the caller to schedule.
 func WithMaxRetries(maxRetries int) ClientOption {
 	return func(c *Client) {
 		c.maxRetries = maxRetries
@@ -66,6 +100,56 @@ func WithHTTPClient(httpClient *http.Client) ClientOption {
 	}
 }
 
+// WithAppInfo identifies your application in the User-Agent header, so
+// ActorHub support can attribute traffic to your integration. The header
+// becomes, for example, "actorhub-go/0.1.0 render-farm/2.3.1 (https://example.com)".
+// version and url may be empty.
+func WithAppInfo(name, version, url string) ClientOption {
+	return func(c *Client) {
+		appInfo := name
+		if version != "" {
+			appInfo += "/" + version
+		}
+		if url != "" {
+			appInfo += " (" + url + ")"
+		}
+		c.userAgent = "actorhub-go/" + Version + " " + appInfo
+	}
+}
+
+// WithBiometricConsentRequired makes biometric requests such as Verify,
+// VerifyVoice and CheckConsent fail with a BiometricConsentRequiredError
+// unless the request references consent evidence recorded with
+// RecordBiometricConsent, as required by biometric privacy laws such as BIPA.
+func WithBiometricConsentRequired() ClientOption {
+	return func(c *Client) {
+		c.requireBiometricConsent = true
+	}
+}
+
+// WithMinorProtection enables strict child-safety mode: Verify, VerifyVideo
+// and CheckConsent fail with a MinorDetectedError whenever a face may belong
+// to a minor, regardless of consent flags.
+func WithMinorProtection() ClientOption {
+	return func(c *Client) {
+		c.minorProtection = true
+	}
+}
+
+// checkMinors returns a MinorDetectedError if minor protection is enabled and
+// any of faces may belong to a minor.
+func checkMinors[T interface{ PotentialMinor() bool }](c *Client, faces []T, requestID string) error {
+	if !c.minorProtection {
+		return nil
+	}
+	for _, face := range faces {
+		if face.PotentialMinor() {
+			return NewMinorDetectedError("", requestID)
+		}
+	}
+	return nil
+}
+
 // NewClient creates a new ActorHub API client.
 func NewClient(apiKey string, opts ...ClientOption) *Client {
 	c := &Client{
@@ -74,39 +158,104 @@ func NewClient(apiKey string, opts ...ClientOption) *Client {
 		httpClient: &http.Client{
 			Timeout: DefaultTimeout,
 		},
-		maxRetries: DefaultMaxRetries,
+		userAgent:   "actorhub-go/" + Version,
+		maxRetries:  DefaultMaxRetries,
+		rateLimit:   &rateLimitTracker{},
+		jwks:        &jwksCache{},
+		debug:       &debugLog{},
+		stats:       &statsTracker{endpoints: make(map[string]*endpointSamples)},
+		retryBudget: newRetryBudget(defaultRetryBudgetTokens, defaultRetryBudgetRatio),
 	}
 
 	for _, opt := range opts {
 		opt(c)
 	}
 
+	// Phase timeouts replace the overall timeout, which would otherwise cut
+	// off slow uploads and inference regardless of either setting. A phase
+	// left unset keeps the overall timeout rather than becoming unbounded.
+	if c.uploadTimeout > 0 || c.processingTimeout > 0 {
+		if c.uploadTimeout <= 0 {
+			c.uploadTimeout = c.httpClient.Timeout
+		}
+		if c.processingTimeout <= 0 {
+			c.processingTimeout = c.httpClient.Timeout
+		}
+		httpClient := *c.httpClient
+		httpClient.Timeout = 0
+		c.httpClient = &httpClient
+	}
+
+	c.configureDialer()
+
 	return c
 }
 
-// doRequest performs an HTTP request with retry logic.
+// WithKey returns a client that calls the API with apiKey, for scoping work
+// to one tenant of a multi-tenant service. It is cheap to create: the new
+// client shares this client's options, connections, retry budget, caches,
+// debug output, metrics, and statistics. Only rate-limit state, which the
+// API tracks per key, starts afresh.
+func (c *Client) WithKey(apiKey string) *Client {
+	derived := *c
+	derived.apiKey = apiKey
+	derived.rateLimit = &rateLimitTracker{}
+	return &derived
+}
+
+// doRequest performs an HTTP request with retry logic, tagging it and any
+// error it returns with a client request ID and reporting it to the metrics
+// collector.
 func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	path = withFields(ctx, method, path)
+	ctx, clientRequestID := ensureClientRequestID(ctx)
+	ctx, call := c.startCall(ctx, method, path)
+	err := c.doRequestWithRetry(ctx, method, path, body, result)
+	if c.encryption.keyRetired(err) {
+		err = c.doRequestWithRetry(ctx, method, path, body, result)
+	}
+	c.finishCall(call, err)
+	return attachClientRequestID(ctx, err, clientRequestID)
+}
+
+// doRequestWithRetry performs an HTTP request, retrying rate-limited and
+// failed attempts.
+func (c *Client) doRequestWithRetry(ctx context.Context, method, path string, body interface{}, result interface{}) error {
 	var lastErr error
+	start := time.Now()
 
 	for attempt := 0; attempt < c.maxRetries; attempt++ {
-		err := c.doRequestOnce(ctx, method, path, body, result)
+		err := c.doAttempt(ctx, method, path, body, result)
 		if err == nil {
+			c.retryBudget.earn()
 			return nil
 		}
 
 		lastErr = err
 
 		// Only retry on rate limit or server errors
-		switch err.(type) {
+		switch e := err.(type) {
 		case *RateLimitError, *ServerError:
-			waitTime := time.Duration(1<<attempt) * time.Second
-			if waitTime > 10*time.Second {
-				waitTime = 10 * time.Second
+			if attempt+1 >= c.maxRetries {
+				return err
+			}
+			waitTime, ok := retryWait(ctx, attempt+1, e)
+			if !ok {
+				return err
+			}
+			if c.maxElapsedTime > 0 && time.Since(start)+waitTime > c.maxElapsedTime {
+				return err
+			}
+			if !c.retryBudget.spend() {
+				return err
 			}
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
 			case <-time.After(waitTime):
+				if call := callStatsFrom(ctx); call != nil {
+					call.retries++
+				}
 				continue
 			}
 		default:
@@ -122,42 +271,177 @@ func (c *Client) doRequestOnce(ctx context.Context, method, path string, body in
 	reqURL := c.baseURL + path
 
 	var reqBody io.Reader
-	if body != nil {
+	var payload []byte
+	contentType := "application/json"
+	contentEncoding := ""
+	encrypted := false
+	clientRequestID := ClientRequestID(ctx)
+	raw, _ := body.(*rawBody)
+	if raw != nil {
+		if c.encryption != nil {
+			return ErrEncryptionUnsupported
+		}
+		payload = raw.data
+		reqBody = bytes.NewReader(payload)
+		contentType = raw.contentType
+		c.debugRequest(method, path, clientRequestID, nil, raw)
+	} else if body != nil {
 		jsonBody, err := json.Marshal(body)
 		if err != nil {
 			return fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		reqBody = bytes.NewReader(jsonBody)
+		if c.encryption != nil {
+			jsonBody, encrypted, err = c.encryptPayload(ctx, jsonBody)
+			if err != nil {
+				return err
+			}
+		}
+		c.debugRequest(method, path, clientRequestID, jsonBody, nil)
+		if c.compressMinSize > 0 && len(jsonBody) >= c.compressMinSize {
+			jsonBody, err = gzipBody(jsonBody)
+			if err != nil {
+				return err
+			}
+			contentEncoding = "gzip"
+		}
+		payload = jsonBody
+		reqBody = bytes.NewReader(payload)
+	} else {
+		c.debugRequest(method, path, clientRequestID, nil, nil)
 	}
 
+	ctx, stop := c.phaseTimeouts(ctx)
+	defer stop()
+
 	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("X-API-Key", c.apiKey)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("User-Agent", "actorhub-go/"+Version)
+	req.Header.Set("Content-Type", contentType)
+	if contentEncoding != "" {
+		req.Header.Set("Content-Encoding", contentEncoding)
+	}
+	if encrypted {
+		req.Header.Set(encryptionHeader, encryptionScheme)
+	}
+	req.Header.Set("Accept-Encoding", c.acceptEncoding())
+	if clientRequestID != "" {
+		req.Header.Set(clientRequestIDHeader, clientRequestID)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	// The request is complete, conditional headers included, before it is
+	// signed. The signature covers the method, URI, and body, not headers.
+	cache := c.beginCache(req, path, result)
+	c.signRequest(req, payload)
 
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		if timeoutErr := phaseTimeoutErr(ctx); timeoutErr != nil {
+			err = timeoutErr
+		}
+		c.debugResponse(method, path, clientRequestID, 0, time.Since(start), nil, err)
 		return fmt.Errorf("failed to send request: %w", err)
 	}
+	if err := c.decodeResponse(resp); err != nil {
+		resp.Body.Close()
+		return err
+	}
 	defer resp.Body.Close()
 
-	return c.handleResponse(resp, result)
+	c.recordResponse(ctx, resp)
+	if cache != nil {
+		cache.substitute(resp)
+	}
+
+	capture := c.captureResponse(&resp.Body)
+	err = c.handleResponse(ctx, resp, result)
+	if cache != nil {
+		c.finishCache(cache, resp, err)
+	}
+	c.debugResponse(method, path, clientRequestID, resp.StatusCode, time.Since(start), capture, err)
+	return err
 }
 
 // handleResponse processes the HTTP response.
-func (c *Client) handleResponse(resp *http.Response, result interface{}) error {
+func (c *Client) handleResponse(ctx context.Context, resp *http.Response, result interface{}) error {
 	requestID := resp.Header.Get("X-Request-ID")
 
+	// Stream successful downloads unless the whole body is needed for verification.
+	if w, ok := result.(io.Writer); ok && resp.StatusCode < 400 && !c.verifyResponses {
+		captureAPIResponse(ctx, resp, nil)
+		return copyResponseBody(w, resp.Body)
+	}
+
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	if resp.StatusCode == http.StatusUnauthorized {
+	// Error bodies may echo request payloads; never let them reach errors unredacted.
+	if resp.StatusCode >= 400 {
+		respBody = []byte(c.Redact(string(respBody)))
+	}
+	captureAPIResponse(ctx, resp, respBody)
+
+	if resp.StatusCode >= 400 {
+		return responseError(resp.StatusCode, resp.Header, respBody, requestID)
+	}
+
+	if c.verifyResponses {
+		if err := c.verifyResponseSignature(ctx, resp.Header, respBody); err != nil {
+			return err
+		}
+	}
+
+	if w, ok := result.(io.Writer); ok {
+		if _, err := w.Write(respBody); err != nil {
+			return fmt.Errorf("failed to write response body: %w", err)
+		}
+		return nil
+	}
+
+	if result != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// copyResponseBody streams a response body to w, reporting whether a
+// failure came from reading the body or from writing to w.
+func copyResponseBody(w io.Writer, body io.Reader) error {
+	dst := &trackingWriter{w: w}
+	if _, err := io.Copy(dst, body); err != nil {
+		if dst.err != nil {
+			return fmt.Errorf("failed to write response body: %w", err)
+		}
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	return nil
+}
+
+// trackingWriter records the error returned by w, if any.
+type trackingWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (t *trackingWriter) Write(p []byte) (int, error) {
+	n, err := t.w.Write(p)
+	if err != nil {
+		t.err = err
+	}
+	return n, err
+}
+
+// responseError converts an error response into the matching error type.
+func responseError(statusCode int, header http.Header, respBody []byte, requestID string) error {
+	if statusCode == http.StatusUnauthorized {
 		var errResp map[string]interface{}
 		json.Unmarshal(respBody, &errResp)
 		message := "Invalid or missing API key"
@@ -167,7 +451,27 @@ func (c *Client) handleResponse(resp *http.Response, result interface{}) error {
 		return NewAuthenticationError(message, requestID)
 	}
 
-	if resp.StatusCode == http.StatusNotFound {
+	if statusCode == http.StatusPaymentRequired {
+		var errResp map[string]interface{}
+		json.Unmarshal(respBody, &errResp)
+		message := "Payment required"
+		if detail, ok := errResp["detail"].(string); ok {
+			message = detail
+		}
+		return NewPaymentRequiredError(message, errResp, requestID)
+	}
+
+	if statusCode == http.StatusForbidden {
+		var errResp map[string]interface{}
+		json.Unmarshal(respBody, &errResp)
+		message := "API key is not allowed to perform this request"
+		if detail, ok := errResp["detail"].(string); ok {
+			message = detail
+		}
+		return NewForbiddenError(message, errResp, requestID)
+	}
+
+	if statusCode == http.StatusNotFound {
 		var errResp map[string]interface{}
 		json.Unmarshal(respBody, &errResp)
 		message := "Resource not found"
@@ -177,7 +481,17 @@ func (c *Client) handleResponse(resp *http.Response, result interface{}) error {
 		return NewNotFoundError(message, requestID)
 	}
 
-	if resp.StatusCode == http.StatusUnprocessableEntity {
+	if statusCode == http.StatusRequestEntityTooLarge {
+		var errResp map[string]interface{}
+		json.Unmarshal(respBody, &errResp)
+		message := "Request body too large"
+		if detail, ok := errResp["detail"].(string); ok {
+			message = detail
+		}
+		return NewRequestTooLargeError(message, errResp, requestID)
+	}
+
+	if statusCode == http.StatusUnprocessableEntity {
 		var errResp map[string]interface{}
 		json.Unmarshal(respBody, &errResp)
 		message := "Validation error"
@@ -188,58 +502,58 @@ func (c *Client) handleResponse(resp *http.Response, result interface{}) error {
 		return NewValidationError(message, errors, requestID)
 	}
 
-	if resp.StatusCode == http.StatusTooManyRequests {
+	if statusCode == http.StatusConflict {
 		var errResp map[string]interface{}
 		json.Unmarshal(respBody, &errResp)
-		message := "Rate limit exceeded"
+		message := "Request conflicts with the current state of the resource"
 		if detail, ok := errResp["detail"].(string); ok {
 			message = detail
 		}
-		retryAfter := 0
-		if ra := resp.Header.Get("Retry-After"); ra != "" {
-			retryAfter, _ = strconv.Atoi(ra)
-		}
-		return NewRateLimitError(message, retryAfter, requestID)
+		return NewConflictError(message, errResp, requestID)
 	}
 
-	if resp.StatusCode >= 500 {
+	if statusCode == http.StatusTooManyRequests {
 		var errResp map[string]interface{}
 		json.Unmarshal(respBody, &errResp)
-		message := fmt.Sprintf("Server error: %d", resp.StatusCode)
+		message := "Rate limit exceeded"
 		if detail, ok := errResp["detail"].(string); ok {
 			message = detail
 		}
-		return NewServerError(message, resp.StatusCode, requestID)
+		retryAfter := parseRetryAfter(header.Get("Retry-After"), time.Now())
+		return NewRateLimitError(message, int((retryAfter+time.Second-1)/time.Second), requestID)
 	}
 
-	if resp.StatusCode >= 400 {
+	if statusCode >= 500 {
 		var errResp map[string]interface{}
 		json.Unmarshal(respBody, &errResp)
-		message := fmt.Sprintf("API error: %d", resp.StatusCode)
+		message := fmt.Sprintf("Server error: %d", statusCode)
 		if detail, ok := errResp["detail"].(string); ok {
 			message = detail
 		}
-		return &ActorHubError{
-			Message:      message,
-			StatusCode:   resp.StatusCode,
-			ResponseData: errResp,
-			RequestID:    requestID,
-		}
+		return NewServerError(message, statusCode, requestID)
 	}
 
-	if result != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, result); err != nil {
-			return fmt.Errorf("failed to unmarshal response: %w", err)
-		}
+	var errResp map[string]interface{}
+	json.Unmarshal(respBody, &errResp)
+	message := fmt.Sprintf("API error: %d", statusCode)
+	if detail, ok := errResp["detail"].(string); ok {
+		message = detail
+	}
+	return &ActorHubError{
+		Message:      message,
+		StatusCode:   statusCode,
+		ResponseData: errResp,
+		RequestID:    requestID,
 	}
-
-	return nil
 }
 
 // Verify checks if an image contains protected identities.
 func (c *Client) Verify(ctx context.Context, req *VerifyRequest) (*VerifyResponse, error) {
-	if req.ImageURL == "" && req.ImageBase64 == "" {
-		return nil, NewValidationError("Must provide image_url or image_base64", nil, "")
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if c.requireBiometricConsent && req.ConsentEvidenceID == "" {
+		return nil, NewBiometricConsentRequiredError("")
 	}
 
 	var result VerifyResponse
@@ -248,103 +562,162 @@ func (c *Client) Verify(ctx context.Context, req *VerifyRequest) (*VerifyRespons
 		return nil, err
 	}
 
+	if err := checkMinors(c, result.Identities, result.RequestID); err != nil {
+		return nil, err
+	}
+
 	return &result, nil
 }
 
-// GetIdentity retrieves identity details by ID.
-func (c *Client) GetIdentity(ctx context.Context, identityID string) (*IdentityResponse, error) {
-	var result IdentityResponse
-	err := c.doRequest(ctx, http.MethodGet, "/api/v1/identity/"+identityID, nil, &result)
+// CheckGeneratedOutput verifies that a finished generation only contains
+// protected identities covered by the given licenses, catching likenesses
+// that emerge in outputs even when the inputs were cleared.
+func (c *Client) CheckGeneratedOutput(ctx context.Context, image ImageInput, licenseIDs ...string) (*OutputCheckResult, error) {
+	if err := image.Validate(); err != nil {
+		return nil, err
+	}
+
+	if licenseIDs == nil {
+		licenseIDs = []string{}
+	}
+	req := struct {
+		ImageInput
+		LicenseIDs []string `json:"license_ids"`
+	}{image, licenseIDs}
+
+	var result OutputCheckResult
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/identity/verify/output", req, &result)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := checkMinors(c, result.Identities, result.RequestID); err != nil {
+		return nil, err
+	}
+
 	return &result, nil
 }
 
-// CheckConsent checks consent status for face before AI generation.
-func (c *Client) CheckConsent(ctx context.Context, req *ConsentCheckRequest) (*ConsentCheckResponse, error) {
-	if req.ImageURL == "" && req.ImageBase64 == "" && len(req.FaceEmbedding) == 0 {
-		return nil, NewValidationError("Must provide image_url, image_base64, or face_embedding", nil, "")
+// VerifyVideo checks a video for protected identities, returning the time
+// ranges in which each identity appears along with sampled frame bounding
+// boxes.
+func (c *Client) VerifyVideo(ctx context.Context, req *VideoVerifyRequest) (*VideoVerifyResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if c.requireBiometricConsent && req.ConsentEvidenceID == "" {
+		return nil, NewBiometricConsentRequiredError("")
 	}
 
-	var result ConsentCheckResponse
-	err := c.doRequest(ctx, http.MethodPost, "/api/v1/consent/check", req, &result)
+	body, err := videoVerifyBody(req, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var result VideoVerifyResponse
+	err = c.doRequest(ctx, http.MethodPost, "/api/v1/identity/verify/video", body, &result)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := checkMinors(c, result.Identities, result.RequestID); err != nil {
+		return nil, err
+	}
+
 	return &result, nil
 }
 
-// ListMarketplace searches marketplace listings.
-func (c *Client) ListMarketplace(ctx context.Context, req *MarketplaceListRequest) ([]MarketplaceListingResponse, error) {
-	params := url.Values{}
-
-	if req != nil {
-		if req.Query != "" {
-			params.Set("query", req.Query)
-		}
-		if req.Category != "" {
-			params.Set("category", req.Category)
-		}
-		if len(req.Tags) > 0 {
-			params.Set("tags", strings.Join(req.Tags, ","))
-		}
-		if req.Featured != nil {
-			params.Set("featured", strconv.FormatBool(*req.Featured))
-		}
-		if req.MinPrice != nil {
-			params.Set("min_price", strconv.FormatFloat(*req.MinPrice, 'f', -1, 64))
-		}
-		if req.MaxPrice != nil {
-			params.Set("max_price", strconv.FormatFloat(*req.MaxPrice, 'f', -1, 64))
-		}
-		if req.SortBy != "" {
-			params.Set("sort_by", req.SortBy)
-		}
-		if req.Page > 0 {
-			params.Set("page", strconv.Itoa(req.Page))
-		}
-		if req.Limit > 0 {
-			params.Set("limit", strconv.Itoa(req.Limit))
-		}
+// SubmitVerifyJob queues a video verification to run asynchronously, for
+// media too large to verify within a request timeout. When the job finishes,
+// ActorHub POSTs it to callbackURL, if set; otherwise wait for it with
+// WaitForJob. The job's result is a VideoVerifyResponse.
+func (c *Client) SubmitVerifyJob(ctx context.Context, req *VideoVerifyRequest, callbackURL string) (*Job, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if c.requireBiometricConsent && req.ConsentEvidenceID == "" {
+		return nil, NewBiometricConsentRequiredError("")
 	}
 
-	path := "/api/v1/marketplace/listings"
-	if len(params) > 0 {
-		path += "?" + params.Encode()
+	body, err := videoVerifyBody(req, callbackURL)
+	if err != nil {
+		return nil, err
 	}
 
-	var result []MarketplaceListingResponse
-	err := c.doRequest(ctx, http.MethodGet, path, nil, &result)
+	var result Job
+	err = c.doRequest(ctx, http.MethodPost, "/api/v1/identity/verify/jobs", body, &result)
 	if err != nil {
 		return nil, err
 	}
 
-	return result, nil
+	return &result, nil
 }
 
-// GetMyLicenses retrieves licenses purchased by the current user.
-func (c *Client) GetMyLicenses(ctx context.Context, status string, page, limit int) ([]LicenseResponse, error) {
-	params := url.Values{}
-	if status != "" {
-		params.Set("status", status)
+// GetVerifyJob retrieves the status of an asynchronous verification job. It
+// is equivalent to Jobs().GetJob; decode the result of a succeeded job as a
+// VideoVerifyResponse with DecodeResult.
+func (c *Client) GetVerifyJob(ctx context.Context, jobID string) (*Job, error) {
+	return c.Jobs().GetJob(ctx, jobID)
+}
+
+// videoVerifyBody encodes a video verification request as JSON, or as
+// multipart form data when the video is uploaded.
+func videoVerifyBody(req *VideoVerifyRequest, callbackURL string) (interface{}, error) {
+	if req.Video == nil {
+		return struct {
+			*VideoVerifyRequest
+			CallbackURL string `json:"callback_url,omitempty"`
+		}{req, callbackURL}, nil
 	}
-	if page > 0 {
-		params.Set("page", strconv.Itoa(page))
+
+	fields := map[string]string{}
+	if req.SampleFPS > 0 {
+		fields["sample_fps"] = strconv.FormatFloat(req.SampleFPS, 'f', -1, 64)
 	}
-	if limit > 0 {
-		params.Set("limit", strconv.Itoa(limit))
+	if req.IncludeLicenseOptions {
+		fields["include_license_options"] = "true"
+	}
+	if req.ConsentEvidenceID != "" {
+		fields["consent_evidence_id"] = req.ConsentEvidenceID
 	}
+	if callbackURL != "" {
+		fields["callback_url"] = callbackURL
+	}
+	fileName := req.FileName
+	if fileName == "" {
+		fileName = "video"
+	}
+	return newMultipartBody(fields, "file", fileName, req.Video)
+}
 
-	path := "/api/v1/marketplace/licenses/mine"
-	if len(params) > 0 {
-		path += "?" + params.Encode()
+// GetIdentityByHandle retrieves an identity by its exact public handle.
+func (c *Client) GetIdentityByHandle(ctx context.Context, handle string) (*IdentityResponse, error) {
+	handle = strings.TrimPrefix(handle, "@")
+	if handle == "" {
+		return nil, NewValidationError("Must provide handle", nil, "")
 	}
 
-	var result []LicenseResponse
-	err := c.doRequest(ctx, http.MethodGet, path, nil, &result)
+	var result IdentityResponse
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/identity/handle/"+url.PathEscape(handle), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SearchIdentities finds identities whose display name or handle fuzzily
+// matches query, best matches first.
+func (c *Client) SearchIdentities(ctx context.Context, query string) ([]IdentityResponse, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, NewValidationError("Must provide query", nil, "")
+	}
+
+	params := url.Values{}
+	params.Set("query", query)
+
+	var result []IdentityResponse
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/identity/search?"+params.Encode(), nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -352,14 +725,36 @@ func (c *Client) GetMyLicenses(ctx context.Context, status string, page, limit i
 	return result, nil
 }
 
-// PurchaseLicense purchases a license for an identity.
-func (c *Client) PurchaseLicense(ctx context.Context, req *PurchaseLicenseRequest) (*PurchaseResponse, error) {
-	if req.DurationDays == 0 {
-		req.DurationDays = 30
+// GetIdentities retrieves several identities in one request. Results are
+// returned in the order of ids, with Found set to false for unknown IDs.
+func (c *Client) GetIdentities(ctx context.Context, ids []string) ([]IdentityLookup, error) {
+	if len(ids) == 0 {
+		return nil, NewValidationError("Must provide at least one identity ID", nil, "")
 	}
 
-	var result PurchaseResponse
-	err := c.doRequest(ctx, http.MethodPost, "/api/v1/marketplace/license/purchase", req, &result)
+	req := map[string][]string{"ids": ids}
+
+	var result struct {
+		Results []IdentityLookup `json:"results"`
+	}
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/identity/batch", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Results, nil
+}
+
+// RecordBiometricConsent records written consent from a data subject for
+// biometric processing and returns the evidence ID to reference in Verify
+// and CheckConsent requests.
+func (c *Client) RecordBiometricConsent(ctx context.Context, req *BiometricConsentRequest) (*BiometricConsentEvidence, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var result BiometricConsentEvidence
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/consent/biometric", req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -367,10 +762,1299 @@ func (c *Client) PurchaseLicense(ctx context.Context, req *PurchaseLicenseReques
 	return &result, nil
 }
 
-// GetActorPack retrieves Actor Pack status and details.
-func (c *Client) GetActorPack(ctx context.Context, packID string) (*ActorPackResponse, error) {
-	var result ActorPackResponse
-	err := c.doRequest(ctx, http.MethodGet, "/api/v1/actor-packs/status/"+packID, nil, &result)
+// GetIdentity retrieves identity details by ID.
+func (c *Client) GetIdentity(ctx context.Context, identityID string) (*IdentityResponse, error) {
+	var result IdentityResponse
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/identity/"+identityID, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DeactivateIdentity stops protection and marketplace availability for an
+// identity without deleting its data.
+func (c *Client) DeactivateIdentity(ctx context.Context, identityID string) (*IdentityResponse, error) {
+	var result IdentityResponse
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/identity/"+identityID+"/deactivate", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DeleteIdentity permanently deletes an identity and its biometric data.
+// confirm must be true. A ConflictError is returned if deletion is blocked,
+// for example by active licenses.
+func (c *Client) DeleteIdentity(ctx context.Context, identityID string, confirm bool) error {
+	if !confirm {
+		return NewValidationError("Identity deletion must be confirmed", nil, "")
+	}
+
+	return c.doRequest(ctx, http.MethodDelete, "/api/v1/identity/"+identityID+"?confirm=true", nil, nil)
+}
+
+// StartIdentityClaim begins the workflow for a person to claim an identity
+// record. The returned claim references a selfie-liveness challenge to complete.
+func (c *Client) StartIdentityClaim(ctx context.Context, req *StartIdentityClaimRequest) (*IdentityClaim, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var result IdentityClaim
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/identity/claims", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SubmitClaimEvidence attaches evidence, such as a completed liveness session
+// or supporting documents, to an identity claim.
+func (c *Client) SubmitClaimEvidence(ctx context.Context, claimID string, evidence *ClaimEvidence) (*IdentityClaim, error) {
+	var result IdentityClaim
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/identity/claims/"+claimID+"/evidence", evidence, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetClaimStatus retrieves the status of an identity claim.
+func (c *Client) GetClaimStatus(ctx context.Context, claimID string) (*IdentityClaim, error) {
+	var result IdentityClaim
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/identity/claims/"+claimID, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UploadKYCDocument uploads an identity verification document, such as a
+// passport or agency authorization letter, for review. Poll GetKYCDocument
+// for the review outcome.
+func (c *Client) UploadKYCDocument(ctx context.Context, identityID string, docType KYCDocumentType, r io.Reader) (*KYCDocument, error) {
+	if docType == "" {
+		return nil, NewValidationError("Must provide document_type", nil, "")
+	}
+
+	body, err := newMultipartBody(map[string]string{"document_type": string(docType)}, "file", string(docType), r)
+	if err != nil {
+		return nil, err
+	}
+
+	var result KYCDocument
+	err = c.doRequest(ctx, http.MethodPost, "/api/v1/identity/"+identityID+"/kyc/documents", body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetKYCDocument retrieves the review status of an uploaded KYC document.
+func (c *Client) GetKYCDocument(ctx context.Context, identityID, documentID string) (*KYCDocument, error) {
+	var result KYCDocument
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/identity/"+identityID+"/kyc/documents/"+documentID, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// EnrollVoiceSample uploads an audio sample of the identity's voice to build
+// the voice print used to protect against voice cloning.
+func (c *Client) EnrollVoiceSample(ctx context.Context, identityID string, audio io.Reader) (*VoiceSample, error) {
+	body, err := newMultipartBody(nil, "file", "voice-sample", audio)
+	if err != nil {
+		return nil, err
+	}
+
+	var result VoiceSample
+	err = c.doRequest(ctx, http.MethodPost, "/api/v1/identity/"+identityID+"/voice/samples", body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// VerifyVoice checks if an audio sample matches protected voice prints.
+func (c *Client) VerifyVoice(ctx context.Context, req *VoiceVerifyRequest) (*VoiceVerifyResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if c.requireBiometricConsent && req.ConsentEvidenceID == "" {
+		return nil, NewBiometricConsentRequiredError("")
+	}
+
+	var result VoiceVerifyResponse
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/voice/verify", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// VerifyMultiModal checks an image and an audio clip in one request,
+// combining face and voice evidence into a single match per identity.
+func (c *Client) VerifyMultiModal(ctx context.Context, req *MultiModalVerifyRequest) (*MultiModalVerifyResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if c.requireBiometricConsent && req.ConsentEvidenceID == "" {
+		return nil, NewBiometricConsentRequiredError("")
+	}
+
+	var result MultiModalVerifyResponse
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/verify/multimodal", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkMinors(c, result.Identities, result.RequestID); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DetectVoiceClone scores how likely an audio clip is synthetic and reports
+// which protected voices it resembles.
+func (c *Client) DetectVoiceClone(ctx context.Context, audio io.Reader) (*VoiceCloneDetection, error) {
+	body, err := newMultipartBody(nil, "file", "audio", audio)
+	if err != nil {
+		return nil, err
+	}
+
+	var result VoiceCloneDetection
+	err = c.doRequest(ctx, http.MethodPost, "/api/v1/voice/detect-clone", body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetRevenueReport retrieves earnings for an identity over a period, broken down
+// by license type, platform, and time bucket.
+func (c *Client) GetRevenueReport(ctx context.Context, identityID string, period Period) (*RevenueReport, error) {
+	path := "/api/v1/identity/" + identityID + "/revenue"
+	if params := period.values(); len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var result RevenueReport
+	err := c.doRequest(ctx, http.MethodGet, path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetIdentityStats retrieves time-series verification analytics for an identity.
+func (c *Client) GetIdentityStats(ctx context.Context, identityID string, period Period) (*IdentityStats, error) {
+	path := "/api/v1/identity/" + identityID + "/stats"
+	if params := period.values(); len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var result IdentityStats
+	err := c.doRequest(ctx, http.MethodGet, path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListDetectionAlerts retrieves events where the identity was matched in
+// third-party verification calls without a license. A zero since returns the
+// most recent alerts.
+func (c *Client) ListDetectionAlerts(ctx context.Context, identityID string, since time.Time) ([]DetectionAlert, error) {
+	path := "/api/v1/identity/" + identityID + "/alerts"
+	if !since.IsZero() {
+		params := url.Values{}
+		params.Set("since", since.UTC().Format(time.RFC3339))
+		path += "?" + params.Encode()
+	}
+
+	var result []DetectionAlert
+	err := c.doRequest(ctx, http.MethodGet, path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CreateTakedownRequest submits infringing AI content for enforcement on behalf
+// of an identity owner and returns the opened case.
+func (c *Client) CreateTakedownRequest(ctx context.Context, req *TakedownRequest) (*Takedown, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var result Takedown
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/takedowns", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetTakedown retrieves a takedown case and its timeline.
+func (c *Client) GetTakedown(ctx context.Context, caseID string) (*Takedown, error) {
+	var result Takedown
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/takedowns/"+caseID, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListTakedowns retrieves takedown cases submitted by the current account.
+func (c *Client) ListTakedowns(ctx context.Context, status TakedownStatus, page, limit int) ([]Takedown, error) {
+	params := url.Values{}
+	if status != "" {
+		params.Set("status", string(status))
+	}
+	if page > 0 {
+		params.Set("page", strconv.Itoa(page))
+	}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+
+	path := "/api/v1/takedowns"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var result []Takedown
+	err := c.doRequest(ctx, http.MethodGet, path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CreateMonitor subscribes an identity to continuous scanning of the given
+// sources, such as platform names or domains.
+func (c *Client) CreateMonitor(ctx context.Context, identityID string, sources []string) (*Monitor, error) {
+	if len(sources) == 0 {
+		return nil, NewValidationError("Must provide at least one source", nil, "")
+	}
+
+	req := map[string]interface{}{
+		"identity_id": identityID,
+		"sources":     sources,
+	}
+
+	var result Monitor
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/monitors", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListMonitorHits retrieves content found by a monitor.
+func (c *Client) ListMonitorHits(ctx context.Context, monitorID string, page, limit int) ([]MonitorHit, error) {
+	params := url.Values{}
+	if page > 0 {
+		params.Set("page", strconv.Itoa(page))
+	}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+
+	path := "/api/v1/monitors/" + monitorID + "/hits"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var result []MonitorHit
+	err := c.doRequest(ctx, http.MethodGet, path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CheckConsent checks consent status for face before AI generation.
+func (c *Client) CheckConsent(ctx context.Context, req *ConsentCheckRequest) (*ConsentCheckResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if err := c.validatePlatform(req.Platform, req.IntendedUse); err != nil {
+		return nil, err
+	}
+	if c.requireBiometricConsent && req.ConsentEvidenceID == "" {
+		return nil, NewBiometricConsentRequiredError("")
+	}
+
+	var result ConsentCheckResponse
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/consent/check", req, &result)
+	if c.consentCache != nil {
+		key := c.consentCacheKey(req)
+		if err == nil {
+			c.consentCache.put(key, &result)
+		} else if stale, ok := c.consentCache.get(key); ok && staleFallbackAllowed(err) {
+			result, err = *stale, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkMinors(c, result.Faces, result.RequestID); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetPlatformProfile retrieves the canonical intended uses and required
+// consent check fields for a platform.
+func (c *Client) GetPlatformProfile(ctx context.Context, platform Platform) (*PlatformProfile, error) {
+	if platform == "" {
+		return nil, NewValidationError("Must provide platform", nil, "")
+	}
+
+	var result PlatformProfile
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/consent/platforms/"+url.PathEscape(string(platform)), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ScreenPrompt checks a generation prompt for references to protected
+// identities by name and for blocked content categories.
+func (c *Client) ScreenPrompt(ctx context.Context, req *PromptScreenRequest) (*PromptScreenResult, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var result PromptScreenResult
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/consent/screen-prompt", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListConsentChanges retrieves consent changes made by identity owners since
+// the given time, so cached consent decisions can be invalidated.
+func (c *Client) ListConsentChanges(ctx context.Context, since time.Time) ([]ConsentChange, error) {
+	path := "/api/v1/consent/changes"
+	if !since.IsZero() {
+		params := url.Values{}
+		params.Set("since", since.UTC().Format(time.RFC3339))
+		path += "?" + params.Encode()
+	}
+
+	var result []ConsentChange
+	err := c.doRequest(ctx, http.MethodGet, path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListMarketplace searches marketplace listings.
+func (c *Client) ListMarketplace(ctx context.Context, req *MarketplaceListRequest) ([]MarketplaceListingResponse, error) {
+	params, err := marketplaceParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	path := "/api/v1/marketplace/listings"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var result []MarketplaceListingResponse
+	err = c.doRequest(ctx, http.MethodGet, path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// marketplaceParams encodes a listing search as query parameters.
+func marketplaceParams(req *MarketplaceListRequest) (url.Values, error) {
+	params := url.Values{}
+
+	if req != nil {
+		if err := req.Validate(); err != nil {
+			return nil, err
+		}
+		if req.Query != "" {
+			params.Set("query", req.Query)
+		}
+		if req.Category != "" {
+			params.Set("category", req.Category)
+		}
+		if len(req.Tags) > 0 {
+			params.Set("tags", strings.Join(req.Tags, ","))
+		}
+		if req.Featured != nil {
+			params.Set("featured", strconv.FormatBool(*req.Featured))
+		}
+		if req.MinPrice != nil {
+			params.Set("min_price", req.MinPrice.String())
+		}
+		if req.MaxPrice != nil {
+			params.Set("max_price", req.MaxPrice.String())
+		}
+		if req.SortBy != "" {
+			params.Set("sort_by", req.SortBy)
+		}
+		if req.Page > 0 {
+			params.Set("page", strconv.Itoa(req.Page))
+		}
+		if req.Limit > 0 {
+			params.Set("limit", strconv.Itoa(req.Limit))
+		}
+		if len(req.Fields) > 0 {
+			params.Set("fields", strings.Join(req.Fields, ","))
+		}
+	}
+
+	return params, nil
+}
+
+// GetFeaturedListings retrieves the curated featured marketplace listings.
+func (c *Client) GetFeaturedListings(ctx context.Context) ([]MarketplaceListingResponse, error) {
+	var result []MarketplaceListingResponse
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/marketplace/listings/featured", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetTrendingListings retrieves marketplace listings trending over the given window.
+// An empty window uses the server default.
+func (c *Client) GetTrendingListings(ctx context.Context, window TrendingWindow) ([]MarketplaceListingResponse, error) {
+	path := "/api/v1/marketplace/listings/trending"
+	if window != "" {
+		params := url.Values{}
+		params.Set("window", string(window))
+		path += "?" + params.Encode()
+	}
+
+	var result []MarketplaceListingResponse
+	err := c.doRequest(ctx, http.MethodGet, path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListCategories retrieves the valid marketplace categories with listing counts.
+func (c *Client) ListCategories(ctx context.Context) ([]CategoryCount, error) {
+	var result []CategoryCount
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/marketplace/categories", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListTags retrieves the marketplace tags in use with listing counts.
+func (c *Client) ListTags(ctx context.Context) ([]TagCount, error) {
+	var result []TagCount
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/marketplace/tags", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// SearchIdentitiesByImage finds marketplace identities ranked by facial similarity
+// to the given image. A topK of zero uses the server default.
+func (c *Client) SearchIdentitiesByImage(ctx context.Context, image ImageInput, topK int) ([]SimilarIdentity, error) {
+	if err := image.Validate(); err != nil {
+		return nil, err
+	}
+
+	req := struct {
+		ImageInput
+		TopK int `json:"top_k,omitempty"`
+	}{
+		ImageInput: image,
+		TopK:       topK,
+	}
+
+	var result []SimilarIdentity
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/marketplace/search/similar", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetMyLicenses retrieves licenses purchased by the current user.
+func (c *Client) GetMyLicenses(ctx context.Context, status string, page, limit int) ([]LicenseResponse, error) {
+	params := url.Values{}
+	if status != "" {
+		params.Set("status", status)
+	}
+	if page > 0 {
+		params.Set("page", strconv.Itoa(page))
+	}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+
+	path := "/api/v1/marketplace/licenses/mine"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var result []LicenseResponse
+	err := c.doRequest(ctx, http.MethodGet, path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// PurchaseLicense purchases a license for an identity.
+func (c *Client) PurchaseLicense(ctx context.Context, req *PurchaseLicenseRequest) (*PurchaseResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if req.DurationDays == 0 {
+		req.DurationDays = 30
+	}
+
+	var result PurchaseResponse
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/marketplace/license/purchase", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// PurchaseLicenses purchases licenses for multiple identities in a single checkout session.
+func (c *Client) PurchaseLicenses(ctx context.Context, reqs []*PurchaseLicenseRequest) (*BulkPurchaseResponse, error) {
+	if len(reqs) == 0 {
+		return nil, NewValidationError("Must provide at least one license", nil, "")
+	}
+
+	items := make([]PurchaseLicenseRequest, len(reqs))
+	for i, req := range reqs {
+		if req == nil {
+			path := fmt.Sprintf("items[%d]", i)
+			return nil, NewValidationError("Must provide "+path, map[string]interface{}{path: "Must provide " + path}, "")
+		}
+		if err := req.Validate(); err != nil {
+			return nil, itemError("items", i, err)
+		}
+		// Default a copy so the caller's requests are left as given.
+		items[i] = *req
+		if items[i].DurationDays == 0 {
+			items[i].DurationDays = 30
+		}
+	}
+
+	body := map[string]interface{}{"items": items}
+
+	var result BulkPurchaseResponse
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/marketplace/license/purchase/bulk", body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// TransferLicense reassigns an unused license to a different project, subject to
+// server-side transfer policy.
+func (c *Client) TransferLicense(ctx context.Context, licenseID, newProjectName, newDescription string) (*LicenseResponse, error) {
+	if newProjectName == "" {
+		return nil, NewValidationError("Must provide project_name", nil, "")
+	}
+
+	req := map[string]string{
+		"project_name":        newProjectName,
+		"project_description": newDescription,
+	}
+
+	var result LicenseResponse
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/marketplace/licenses/"+licenseID+"/transfer", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ExtendLicense extends an active license by extraDays, returning the prorated
+// charge and the new expiry.
+func (c *Client) ExtendLicense(ctx context.Context, licenseID string, extraDays int) (*LicenseExtension, error) {
+	if extraDays <= 0 {
+		return nil, NewValidationError("extra_days must be positive", nil, "")
+	}
+
+	req := map[string]int{"extra_days": extraDays}
+
+	var result LicenseExtension
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/marketplace/licenses/"+licenseID+"/extend", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetLicenseQuote returns the exact pricing for a license without creating a checkout session.
+func (c *Client) GetLicenseQuote(ctx context.Context, req *PurchaseLicenseRequest) (*LicenseQuote, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if req.DurationDays == 0 {
+		req.DurationDays = 30
+	}
+
+	var result LicenseQuote
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/marketplace/license/quote", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetCheckoutSession retrieves the status of a license checkout session.
+func (c *Client) GetCheckoutSession(ctx context.Context, sessionID string) (*CheckoutSession, error) {
+	var result CheckoutSession
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/marketplace/checkout/"+sessionID, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// WaitForCheckout polls a checkout session until payment completes and returns
+// the created license. It returns ErrCheckoutExpired if the session expires first.
+func (c *Client) WaitForCheckout(ctx context.Context, sessionID string) (*LicenseResponse, error) {
+	for {
+		session, err := c.GetCheckoutSession(ctx, sessionID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch session.Status {
+		case CheckoutStatusComplete:
+			// The license may be issued slightly after payment is confirmed.
+			if session.License != nil {
+				return session.License, nil
+			}
+		case CheckoutStatusExpired:
+			return nil, ErrCheckoutExpired
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(checkoutPollInterval):
+		}
+	}
+}
+
+// ListPaymentMethods retrieves the saved payment methods for the account.
+func (c *Client) ListPaymentMethods(ctx context.Context) ([]PaymentMethod, error) {
+	var result []PaymentMethod
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/billing/payment-methods", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// AttachPaymentMethod saves a tokenized payment method to the account.
+func (c *Client) AttachPaymentMethod(ctx context.Context, req *AttachPaymentMethodRequest) (*PaymentMethod, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var result PaymentMethod
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/billing/payment-methods", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListBillingProfiles retrieves the billing profiles available for server-side purchases.
+func (c *Client) ListBillingProfiles(ctx context.Context) ([]BillingProfile, error) {
+	var result []BillingProfile
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/billing/profiles", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListInvoices retrieves billing invoices for the account.
+func (c *Client) ListInvoices(ctx context.Context, status string, page, limit int) ([]Invoice, error) {
+	params := url.Values{}
+	if status != "" {
+		params.Set("status", status)
+	}
+	if page > 0 {
+		params.Set("page", strconv.Itoa(page))
+	}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+
+	path := "/api/v1/billing/invoices"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var result []Invoice
+	err := c.doRequest(ctx, http.MethodGet, path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetReceiptPDF downloads the PDF receipt for an invoice and writes it to w.
+func (c *Client) GetReceiptPDF(ctx context.Context, invoiceID string, w io.Writer, opts ...DownloadOption) error {
+	_, err := c.Download(ctx, "/api/v1/billing/invoices/"+invoiceID+"/receipt.pdf", w, opts...)
+	return err
+}
+
+// CreateDispute opens a dispute on a verification result or takedown.
+func (c *Client) CreateDispute(ctx context.Context, req *CreateDisputeRequest) (*Dispute, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var result Dispute
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/disputes", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetDispute retrieves dispute details by ID.
+func (c *Client) GetDispute(ctx context.Context, disputeID string) (*Dispute, error) {
+	var result Dispute
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/disputes/"+disputeID, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListDisputes retrieves disputes opened by the current account.
+func (c *Client) ListDisputes(ctx context.Context, status DisputeStatus, page, limit int) ([]Dispute, error) {
+	params := url.Values{}
+	if status != "" {
+		params.Set("status", string(status))
+	}
+	if page > 0 {
+		params.Set("page", strconv.Itoa(page))
+	}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+
+	path := "/api/v1/disputes"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var result []Dispute
+	err := c.doRequest(ctx, http.MethodGet, path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ConnectPayoutAccount connects a payout account used to receive creator earnings.
+// The returned account may include an onboarding URL the creator must complete.
+func (c *Client) ConnectPayoutAccount(ctx context.Context, req *ConnectPayoutAccountRequest) (*PayoutAccount, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var result PayoutAccount
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/payouts/account", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetPayoutAccount retrieves the connected payout account.
+func (c *Client) GetPayoutAccount(ctx context.Context) (*PayoutAccount, error) {
+	var result PayoutAccount
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/payouts/account", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListPayouts retrieves pending and completed payouts.
+func (c *Client) ListPayouts(ctx context.Context, status PayoutStatus, page, limit int) ([]Payout, error) {
+	params := url.Values{}
+	if status != "" {
+		params.Set("status", string(status))
+	}
+	if page > 0 {
+		params.Set("page", strconv.Itoa(page))
+	}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+
+	path := "/api/v1/payouts"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var result []Payout
+	err := c.doRequest(ctx, http.MethodGet, path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetPayoutSchedule retrieves the payout schedule for the connected account.
+func (c *Client) GetPayoutSchedule(ctx context.Context) (*PayoutSchedule, error) {
+	var result PayoutSchedule
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/payouts/schedule", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListTransactions retrieves purchases, refunds, and payouts. Pass the returned
+// NextCursor as Cursor to fetch the following page.
+func (c *Client) ListTransactions(ctx context.Context, req *TransactionListRequest) (*TransactionList, error) {
+	params, err := transactionParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	path := "/api/v1/billing/transactions"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var result TransactionList
+	err = c.doRequest(ctx, http.MethodGet, path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// transactionParams encodes a transaction listing as query parameters.
+func transactionParams(req *TransactionListRequest) (url.Values, error) {
+	params := url.Values{}
+
+	if req != nil {
+		if err := req.Validate(); err != nil {
+			return nil, err
+		}
+		if len(req.Types) > 0 {
+			types := make([]string, len(req.Types))
+			for i, t := range req.Types {
+				types[i] = string(t)
+			}
+			params.Set("types", strings.Join(types, ","))
+		}
+		if !req.Since.IsZero() {
+			params.Set("since", req.Since.UTC().Format(time.RFC3339))
+		}
+		if !req.Until.IsZero() {
+			params.Set("until", req.Until.UTC().Format(time.RFC3339))
+		}
+		if req.Cursor != "" {
+			params.Set("cursor", req.Cursor)
+		}
+		if req.Limit > 0 {
+			params.Set("limit", strconv.Itoa(req.Limit))
+		}
+	}
+
+	return params, nil
+}
+
+// GetServiceStatus retrieves the health of the API and its components, with
+// notes on ongoing incidents. Use it to pause non-critical work while the
+// API is degraded.
+func (c *Client) GetServiceStatus(ctx context.Context) (*ServiceStatus, error) {
+	var result ServiceStatus
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/status", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetAccount retrieves the account and API key the client is authenticated as.
+func (c *Client) GetAccount(ctx context.Context) (*Account, error) {
+	var result Account
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/account/me", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreateAPIKey creates a new API key. The client must be authenticated with an
+// admin key. The secret is only returned once, at creation time.
+func (c *Client) CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest) (*CreatedAPIKey, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var result CreatedAPIKey
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/api-keys", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListAPIKeys retrieves the API keys for the account.
+func (c *Client) ListAPIKeys(ctx context.Context) ([]APIKey, error) {
+	var result []APIKey
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/api-keys", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// RevokeAPIKey revokes an API key by ID.
+func (c *Client) RevokeAPIKey(ctx context.Context, keyID string) error {
+	return c.doRequest(ctx, http.MethodDelete, "/api/v1/api-keys/"+keyID, nil, nil)
+}
+
+// EmbedWatermark applies ActorHub's invisible watermark to an image, binding
+// it to a license. See the watermark package for an image.Image helper.
+func (c *Client) EmbedWatermark(ctx context.Context, req *WatermarkEmbedRequest) (*WatermarkEmbedResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var result WatermarkEmbedResponse
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/watermark/embed", req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DetectWatermark checks whether an image carries an ActorHub watermark and
+// returns the associated license and identity if found.
+func (c *Client) DetectWatermark(ctx context.Context, image ImageInput) (*WatermarkDetection, error) {
+	if err := image.Validate(); err != nil {
+		return nil, err
+	}
+
+	var result WatermarkDetection
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/watermark/detect", image, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreateDataDeletionRequest relays a GDPR Article 17 erasure request covering
+// the subject's stored embeddings and verification logs.
+func (c *Client) CreateDataDeletionRequest(ctx context.Context, subject *DataDeletionSubject) (*DataDeletionRequest, error) {
+	if err := subject.Validate(); err != nil {
+		return nil, err
+	}
+
+	var result DataDeletionRequest
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/privacy/deletion-requests", subject, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetDataDeletionRequest retrieves the status of a data deletion request.
+func (c *Client) GetDataDeletionRequest(ctx context.Context, requestID string) (*DataDeletionRequest, error) {
+	var result DataDeletionRequest
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/privacy/deletion-requests/"+requestID, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetTransparencyReport retrieves the structured EU AI Act transparency report
+// for a period.
+func (c *Client) GetTransparencyReport(ctx context.Context, period Period) (*TransparencyReport, error) {
+	path := "/api/v1/compliance/transparency-report"
+	if params := period.values(); len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var result TransparencyReport
+	err := c.doRequest(ctx, http.MethodGet, path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GenerateTransparencyReport exports the transparency report for a period in
+// the given format and writes it to w.
+func (c *Client) GenerateTransparencyReport(ctx context.Context, period Period, format ReportFormat, w io.Writer, opts ...DownloadOption) error {
+	switch format {
+	case ReportFormatJSON, ReportFormatCSV, ReportFormatPDF:
+	default:
+		return NewValidationError(fmt.Sprintf("Unsupported report format: %q", format), nil, "")
+	}
+
+	params := period.values()
+	params.Set("format", string(format))
+
+	_, err := c.Download(ctx, "/api/v1/compliance/transparency-report/export?"+params.Encode(), w, opts...)
+	return err
+}
+
+// ExportAuditLog writes all API activity for a period to w as CSV or NDJSON,
+// recording who called which endpoint, for which identity, and the result.
+func (c *Client) ExportAuditLog(ctx context.Context, period Period, w io.Writer, format ReportFormat, opts ...DownloadOption) error {
+	switch format {
+	case ReportFormatCSV, ReportFormatNDJSON:
+	default:
+		return NewValidationError(fmt.Sprintf("Unsupported audit log format: %q", format), nil, "")
+	}
+
+	params := period.values()
+	params.Set("format", string(format))
+
+	_, err := c.Download(ctx, "/api/v1/compliance/audit-log/export?"+params.Encode(), w, opts...)
+	return err
+}
+
+// GetActorPack retrieves Actor Pack status and details.
+func (c *Client) GetActorPack(ctx context.Context, packID string) (*ActorPackResponse, error) {
+	var result ActorPackResponse
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/actor-packs/status/"+packID, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListActorPackVersions lists the trained model versions of an Actor Pack,
+// newest first.
+func (c *Client) ListActorPackVersions(ctx context.Context, packID string) ([]ActorPackVersion, error) {
+	var result []ActorPackVersion
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/actor-packs/"+packID+"/versions", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetActorPackDownload returns a short-lived signed URL for the Actor Pack's
+// model weights. Set req.Version to pin a validated version; otherwise the
+// current version is returned, which changes when new training runs land.
+// Set req.Format to one of the pack's AvailableFormats to choose the export
+// format.
+func (c *Client) GetActorPackDownload(ctx context.Context, packID string, req *ActorPackDownloadRequest) (*ActorPackDownload, error) {
+	if req != nil {
+		if err := req.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	version := 0
+	if req != nil {
+		version = req.Version
+	}
+	params := actorPackVersionParams(version)
+	if req != nil && req.Format != "" {
+		params.Set("format", string(req.Format))
+	}
+
+	path := "/api/v1/actor-packs/" + packID + "/download"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var result ActorPackDownload
+	err := c.doRequest(ctx, http.MethodGet, path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CanDownloadActorPack reports whether the current API key's account is
+// entitled to download an Actor Pack, with the reason when it is not.
+func (c *Client) CanDownloadActorPack(ctx context.Context, packID string) (*DownloadEntitlement, error) {
+	var result DownloadEntitlement
+	err := c.doRequest(ctx, http.MethodGet, "/api/v1/actor-packs/"+packID+"/entitlement", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreateInferenceToken issues a short-lived signed token that GPU workers
+// present when loading the Actor Pack's weights, tying each generation to a
+// license without sharing the API key. ttl is rounded down to whole seconds.
+// version pins the token to a validated model version as in
+// GetActorPackDownload; 0 binds it to the current version.
+func (c *Client) CreateInferenceToken(ctx context.Context, packID, licenseID string, ttl time.Duration, version int) (*InferenceToken, error) {
+	if licenseID == "" {
+		return nil, NewValidationError("Must provide license_id", nil, "")
+	}
+	if ttl < time.Second {
+		return nil, NewValidationError("ttl must be at least one second", nil, "")
+	}
+	if version < 0 {
+		return nil, NewValidationError("version must not be negative", nil, "")
+	}
+
+	req := map[string]interface{}{
+		"license_id":  licenseID,
+		"ttl_seconds": int(ttl / time.Second),
+	}
+
+	path := "/api/v1/actor-packs/" + packID + "/inference-tokens"
+	if params := actorPackVersionParams(version); len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var result InferenceToken
+	err := c.doRequest(ctx, http.MethodPost, path, req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// actorPackVersionParams returns the query parameters pinning an Actor Pack
+// model version, empty for the current version.
+func actorPackVersionParams(version int) url.Values {
+	params := url.Values{}
+	if version > 0 {
+		params.Set("version", strconv.Itoa(version))
+	}
+	return params
+}
+
+// ArchiveActorPack moves an Actor Pack's model weights to cold storage and
+// removes it from the marketplace. A ConflictError listing the blocking
+// licenses is returned if the pack is referenced by active licenses.
+func (c *Client) ArchiveActorPack(ctx context.Context, packID string) (*ActorPackResponse, error) {
+	var result ActorPackResponse
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/actor-packs/"+packID+"/archive", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DeleteActorPack permanently deletes an Actor Pack and all of its model
+// versions. confirm must be true. A ConflictError listing the blocking
+// licenses is returned if the pack is referenced by active licenses.
+func (c *Client) DeleteActorPack(ctx context.Context, packID string, confirm bool) error {
+	if !confirm {
+		return NewValidationError("Actor Pack deletion must be confirmed", nil, "")
+	}
+
+	return c.doRequest(ctx, http.MethodDelete, "/api/v1/actor-packs/"+packID+"?confirm=true", nil, nil)
+}
+
+// UploadMotionData attaches motion-capture or reference video data to an
+// Actor Pack for training its motion component. fileName should carry the
+// file's extension (for example .bvh, .fbx or .mp4) so the format can be
+// detected.
+func (c *Client) UploadMotionData(ctx context.Context, packID string, kind MotionDataKind, fileName string, r io.Reader) (*MotionDataUpload, error) {
+	if kind == "" {
+		return nil, NewValidationError("Must provide kind", nil, "")
+	}
+
+	body, err := newMultipartBody(map[string]string{"kind": string(kind)}, "file", fileName, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var result MotionDataUpload
+	err = c.doRequest(ctx, http.MethodPost, "/api/v1/actor-packs/"+packID+"/motion", body, &result)
 	if err != nil {
 		return nil, err
 	}
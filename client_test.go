@@ -0,0 +1,103 @@
+package actorhub
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) { return 0, errors.New("disk full") }
+
+type failingReader struct{}
+
+func (failingReader) Read(p []byte) (int, error) { return 0, io.ErrUnexpectedEOF }
+
+func TestCopyResponseBody(t *testing.T) {
+	var buf bytes.Buffer
+	if err := copyResponseBody(&buf, strings.NewReader("data")); err != nil || buf.String() != "data" {
+		t.Errorf("copy: got %q, %v", buf.String(), err)
+	}
+
+	err := copyResponseBody(failingWriter{}, strings.NewReader("data"))
+	if err == nil || !strings.HasPrefix(err.Error(), "failed to write response body") {
+		t.Errorf("write failure reported as %v", err)
+	}
+
+	err = copyResponseBody(&buf, failingReader{})
+	if !errors.Is(err, io.ErrUnexpectedEOF) || !strings.HasPrefix(err.Error(), "failed to read response body") {
+		t.Errorf("read failure reported as %v", err)
+	}
+}
+
+func TestPurchaseLicensesValidatesItems(t *testing.T) {
+	c := NewClient("key", WithBaseURL("http://127.0.0.1:1"), WithMaxRetries(1))
+
+	_, err := c.PurchaseLicenses(context.Background(), []*PurchaseLicenseRequest{{IdentityID: "id_1"}, nil})
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Errors["items[1]"] == nil {
+		t.Errorf("err = %v, want ValidationError for items[1]", err)
+	}
+
+	_, err = c.PurchaseLicenses(context.Background(), []*PurchaseLicenseRequest{{IdentityID: "id_1"}, {DurationDays: 7}})
+	if !errors.As(err, &verr) || verr.Errors["items[1].identity_id"] == nil {
+		t.Errorf("err = %v, want ValidationError for items[1].identity_id", err)
+	}
+}
+
+func TestPurchaseLicensesDefaultsWithoutMutating(t *testing.T) {
+	var sent struct {
+		Items []PurchaseLicenseRequest `json:"items"`
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&sent)
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+	c := NewClient("key", WithBaseURL(srv.URL), WithMaxRetries(1))
+
+	req := &PurchaseLicenseRequest{IdentityID: "id_1"}
+	if _, err := c.PurchaseLicenses(context.Background(), []*PurchaseLicenseRequest{req}); err != nil {
+		t.Fatal(err)
+	}
+	if len(sent.Items) != 1 || sent.Items[0].DurationDays != 30 {
+		t.Errorf("sent items = %+v, want duration_days 30", sent.Items)
+	}
+	if req.DurationDays != 0 {
+		t.Errorf("req.DurationDays = %d, want the caller's request unchanged", req.DurationDays)
+	}
+}
+
+func TestMinorProtection(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/consent/") {
+			w.Write([]byte(`{"request_id":"req_1","faces":[{"protected":false},{"estimated_minor":true}]}`))
+			return
+		}
+		w.Write([]byte(`{"request_id":"req_1","identities":[{"estimated_minor":true}]}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", WithBaseURL(srv.URL), WithMaxRetries(1))
+	if _, err := c.Verify(context.Background(), &VerifyRequest{ImageURL: "https://example.com/a.jpg"}); err != nil {
+		t.Errorf("Verify without minor protection: err = %v, want nil", err)
+	}
+
+	c = NewClient("key", WithBaseURL(srv.URL), WithMaxRetries(1), WithMinorProtection())
+	var minorErr *MinorDetectedError
+	_, err := c.Verify(context.Background(), &VerifyRequest{ImageURL: "https://example.com/a.jpg"})
+	if !errors.As(err, &minorErr) || minorErr.RequestID != "req_1" {
+		t.Errorf("Verify: err = %v, want MinorDetectedError with request ID req_1", err)
+	}
+	_, err = c.CheckConsent(context.Background(), &ConsentCheckRequest{ImageURL: "https://example.com/a.jpg", Platform: PlatformRunway, IntendedUse: IntendedUseVideo})
+	if !errors.As(err, &minorErr) || minorErr.RequestID != "req_1" {
+		t.Errorf("CheckConsent: err = %v, want MinorDetectedError with request ID req_1", err)
+	}
+}
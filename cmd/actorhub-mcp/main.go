@@ -0,0 +1,50 @@
+// Command actorhub-mcp is a Model Context Protocol server that exposes
+// ActorHub verification, consent checks, prompt screening, and marketplace
+// search as tools for AI agents.
+//
+// It speaks MCP over stdio. Configure it in an MCP client with:
+//
+//	{
+//	  "mcpServers": {
+//	    "actorhub": {
+//	      "command": "actorhub-mcp",
+//	      "env": {"ACTORHUB_API_KEY": "your-api-key"}
+//	    }
+//	  }
+//	}
+//
+// Set ACTORHUB_BASE_URL to use a different API endpoint.
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+
+	actorhub "github.com/actorhubai/actorhub-go"
+)
+
+func main() {
+	// stdout carries the protocol, so diagnostics go to stderr.
+	log.SetOutput(os.Stderr)
+	log.SetPrefix("actorhub-mcp: ")
+
+	apiKey := os.Getenv("ACTORHUB_API_KEY")
+	if apiKey == "" {
+		log.Fatal("ACTORHUB_API_KEY environment variable is required")
+	}
+
+	opts := []actorhub.ClientOption{actorhub.WithAppInfo("actorhub-mcp", actorhub.Version, "")}
+	if baseURL := os.Getenv("ACTORHUB_BASE_URL"); baseURL != "" {
+		opts = append(opts, actorhub.WithBaseURL(baseURL))
+	}
+	client := actorhub.NewClient(apiKey, opts...)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := newServer(client).serve(ctx, os.Stdin, os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
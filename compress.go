@@ -0,0 +1,127 @@
+package actorhub
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// defaultCompressionMinSize is the smallest JSON body compressed when
+// WithCompression is given no threshold.
+const defaultCompressionMinSize = 1024
+
+// WithCompression gzips JSON request bodies of at least minSize bytes and
+// sends them with Content-Encoding: gzip. Base64 images and face embeddings
+// compress well, cutting upload time on slow links. A minSize of zero or less
+// uses 1 KiB.
+func WithCompression(minSize int) ClientOption {
+	return func(c *Client) {
+		if minSize <= 0 {
+			minSize = defaultCompressionMinSize
+		}
+		c.compressMinSize = minSize
+	}
+}
+
+// gzipBody compresses a request body.
+func gzipBody(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, fmt.Errorf("failed to compress request body: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress request body: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Decompressor returns a reader that decodes a response body compressed with
+// a particular Content-Encoding.
+type Decompressor func(r io.Reader) (io.ReadCloser, error)
+
+// WithResponseEncoding adds a Content-Encoding the client accepts for
+// responses, such as zstd, alongside the built-in gzip support. Batch and
+// snapshot responses are much smaller with zstd. For example, using
+// github.com/klauspost/compress/zstd:
+//
+//	actorhub.WithResponseEncoding("zstd", func(r io.Reader) (io.ReadCloser, error) {
+//		d, err := zstd.NewReader(r)
+//		if err != nil {
+//			return nil, err
+//		}
+//		return d.IOReadCloser(), nil
+//	})
+func WithResponseEncoding(encoding string, d Decompressor) ClientOption {
+	return func(c *Client) {
+		if c.decompressors == nil {
+			c.decompressors = make(map[string]Decompressor)
+		}
+		c.decompressors[strings.ToLower(encoding)] = d
+	}
+}
+
+// acceptEncoding returns the Accept-Encoding header sent with requests,
+// preferring registered encodings over gzip.
+func (c *Client) acceptEncoding() string {
+	encodings := make([]string, 0, len(c.decompressors)+1)
+	for encoding := range c.decompressors {
+		if encoding != "gzip" {
+			encodings = append(encodings, encoding)
+		}
+	}
+	sort.Strings(encodings)
+	return strings.Join(append(encodings, "gzip"), ", ")
+}
+
+// decodeResponse replaces the body of a compressed response with a reader
+// that decompresses it.
+func (c *Client) decodeResponse(resp *http.Response) error {
+	encoding := strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding")))
+	if encoding == "" || encoding == "identity" {
+		return nil
+	}
+
+	d, ok := c.decompressors[encoding]
+	if !ok && encoding == "gzip" {
+		d = func(r io.Reader) (io.ReadCloser, error) { return gzip.NewReader(r) }
+		ok = true
+	}
+	if !ok {
+		return fmt.Errorf("unsupported response Content-Encoding %q", encoding)
+	}
+
+	decoded, err := d(resp.Body)
+	if errors.Is(err, io.EOF) {
+		decoded, err = io.NopCloser(bytes.NewReader(nil)), nil // empty body
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decompress response body: %w", err)
+	}
+
+	resp.Body = &decodedBody{ReadCloser: decoded, raw: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+// decodedBody closes both a decompressor and the body it reads from.
+type decodedBody struct {
+	io.ReadCloser
+	raw io.ReadCloser
+}
+
+func (b *decodedBody) Close() error {
+	err := b.ReadCloser.Close()
+	if rawErr := b.raw.Close(); err == nil {
+		err = rawErr
+	}
+	return err
+}
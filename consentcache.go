@@ -0,0 +1,102 @@
+package actorhub
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+)
+
+// consentCacheSize is the maximum number of consent decisions kept for the
+// stale fallback.
+const consentCacheSize = 10000
+
+// WithStaleConsentFallback keeps the latest successful CheckConsent response
+// for each distinct request. If the API is unavailable after retries, the
+// cached response is returned instead of an error, provided it is no older
+// than maxAge. Served responses have Stale set and StaleAge recording their
+// age, so callers can decide whether to trust them.
+func WithStaleConsentFallback(maxAge time.Duration) ClientOption {
+	return func(c *Client) {
+		if maxAge <= 0 {
+			c.consentCache = nil
+			return
+		}
+		c.consentCache = &consentCache{maxAge: maxAge, entries: make(map[string]consentCacheEntry)}
+	}
+}
+
+// staleFallbackAllowed reports whether a failed consent check may be
+// answered from the cache: only when the API was unreachable, failing, or
+// rate limiting. A response that arrived but failed signature verification
+// or decoding is never papered over with a cached decision.
+func staleFallbackAllowed(err error) bool {
+	return IsUnavailable(err) && !errors.Is(err, ErrInvalidResponseSignature)
+}
+
+// consentCache holds recent consent decisions keyed by request.
+type consentCache struct {
+	maxAge time.Duration
+
+	mu      sync.Mutex
+	entries map[string]consentCacheEntry
+}
+
+type consentCacheEntry struct {
+	resp ConsentCheckResponse
+	at   time.Time
+}
+
+// consentCacheKey identifies a consent check by its image and parameters,
+// and by API key, since clients derived with WithKey share the cache.
+func (c *Client) consentCacheKey(req *ConsentCheckRequest) string {
+	data, _ := json.Marshal(req)
+	sum := sha256.Sum256(append([]byte(c.apiKey+"\x00"), data...))
+	return hex.EncodeToString(sum[:])
+}
+
+func (c *consentCache) put(key string, resp *ConsentCheckResponse) {
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= consentCacheSize {
+		c.evict(now)
+	}
+	c.entries[key] = consentCacheEntry{resp: *resp, at: now}
+}
+
+// evict drops expired entries, or the oldest entry if none have expired.
+func (c *consentCache) evict(now time.Time) {
+	oldestKey, oldest := "", now
+	for key, entry := range c.entries {
+		if now.Sub(entry.at) > c.maxAge {
+			delete(c.entries, key)
+			continue
+		}
+		if entry.at.Before(oldest) {
+			oldestKey, oldest = key, entry.at
+		}
+	}
+	if len(c.entries) >= consentCacheSize {
+		delete(c.entries, oldestKey)
+	}
+}
+
+// get returns a stale copy of the cached response for key, if it is recent
+// enough.
+func (c *consentCache) get(key string) (*ConsentCheckResponse, bool) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+
+	age := time.Since(entry.at)
+	if !ok || age > c.maxAge {
+		return nil, false
+	}
+	resp := entry.resp
+	resp.Stale = true
+	resp.StaleAge = age
+	return &resp, true
+}
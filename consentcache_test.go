@@ -0,0 +1,59 @@
+package actorhub
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestStaleConsentFallback(t *testing.T) {
+	var reply func(w http.ResponseWriter)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reply(w)
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", WithBaseURL(srv.URL), WithMaxRetries(1), WithStaleConsentFallback(time.Hour))
+	req := &ConsentCheckRequest{
+		ImageURL:    "https://example.com/a.jpg",
+		Platform:    PlatformRunway,
+		IntendedUse: IntendedUseVideo,
+	}
+	ctx := context.Background()
+
+	reply = func(w http.ResponseWriter) {
+		w.Write([]byte(`{"request_id":"req_1","protected":true,"faces_detected":1,"faces":[]}`))
+	}
+	if _, err := c.CheckConsent(ctx, req); err != nil {
+		t.Fatalf("CheckConsent: %v", err)
+	}
+
+	reply = func(w http.ResponseWriter) {
+		w.Write([]byte(`{"protected": "garbled"`))
+	}
+	if _, err := c.CheckConsent(ctx, req); err == nil {
+		t.Error("garbled response was answered from the cache, want an error")
+	}
+
+	reply = func(w http.ResponseWriter) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	resp, err := c.CheckConsent(ctx, req)
+	if err != nil {
+		t.Fatalf("CheckConsent during outage: %v", err)
+	}
+	if !resp.Stale || !resp.Protected {
+		t.Errorf("got Stale=%v Protected=%v, want the cached decision marked stale", resp.Stale, resp.Protected)
+	}
+}
+
+func TestStaleFallbackAllowed(t *testing.T) {
+	if staleFallbackAllowed(&RequestError{Err: ErrInvalidResponseSignature}) {
+		t.Error("signature failure allowed the stale fallback")
+	}
+	if !staleFallbackAllowed(NewServerError("", 502, "")) {
+		t.Error("server error did not allow the stale fallback")
+	}
+}
@@ -0,0 +1,143 @@
+package actorhub
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// debugBodyLimit is the number of body bytes shown in debug output.
+	debugBodyLimit = 2048
+
+	// debugCaptureLimit is the number of response body bytes captured for
+	// debug output, enough for redaction to recognize media and embeddings.
+	debugCaptureLimit = 64 << 10
+)
+
+// WithDebugWriter writes a line to w for every request and response the
+// client makes, with the method, path, status, duration, and the start of
+// the body. Output passes through the client's redaction rules, so API keys,
+// media, and embeddings are removed. Use SetDebugWriter to toggle output at
+// runtime.
+func WithDebugWriter(w io.Writer) ClientOption {
+	return func(c *Client) {
+		c.debug.set(w)
+	}
+}
+
+// SetDebugWriter starts writing debug output to w, or stops it if w is nil.
+// It is safe to call while requests are in flight. See WithDebugWriter.
+func (c *Client) SetDebugWriter(w io.Writer) {
+	c.debug.set(w)
+}
+
+// debugLog is the destination of debug output, shared by clients derived
+// from one another.
+type debugLog struct {
+	out atomic.Pointer[debugOutput]
+}
+
+type debugOutput struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (d *debugLog) set(w io.Writer) {
+	if w == nil {
+		d.out.Store(nil)
+		return
+	}
+	d.out.Store(&debugOutput{w: w})
+}
+
+func (d *debugLog) enabled() bool {
+	return d.out.Load() != nil
+}
+
+// debugf writes one redacted line of debug output.
+func (c *Client) debugf(format string, args ...interface{}) {
+	out := c.debug.out.Load()
+	if out == nil {
+		return
+	}
+	line := c.Redact(fmt.Sprintf(format, args...))
+	out.mu.Lock()
+	defer out.mu.Unlock()
+	fmt.Fprintf(out.w, "actorhub: %s\n", line)
+}
+
+// debugBody formats a body for debug output, truncating it after redaction.
+func (c *Client) debugBody(body []byte, total int) string {
+	s := c.Redact(string(body))
+	truncated := total > len(body)
+	if len(s) > debugBodyLimit {
+		s = s[:debugBodyLimit]
+		truncated = true
+	}
+	if truncated {
+		s += fmt.Sprintf("... (%d bytes)", total)
+	}
+	return s
+}
+
+// debugRequest writes the debug line for an outgoing request.
+func (c *Client) debugRequest(method, path, clientRequestID string, body []byte, raw *rawBody) {
+	if !c.debug.enabled() {
+		return
+	}
+	switch {
+	case raw != nil:
+		c.debugf("--> %s %s id=%s body=[%d bytes %s]", method, path, clientRequestID, len(raw.data), raw.contentType)
+	case body != nil:
+		c.debugf("--> %s %s id=%s body=%s", method, path, clientRequestID, c.debugBody(body, len(body)))
+	default:
+		c.debugf("--> %s %s id=%s", method, path, clientRequestID)
+	}
+}
+
+// debugCapture records the start of a response body as it is read.
+type debugCapture struct {
+	io.ReadCloser
+	buf   bytes.Buffer
+	total int
+}
+
+func (d *debugCapture) Read(p []byte) (int, error) {
+	n, err := d.ReadCloser.Read(p)
+	if room := debugCaptureLimit - d.buf.Len(); room > 0 {
+		d.buf.Write(p[:min(n, room)])
+	}
+	d.total += n
+	return n, err
+}
+
+// captureResponse wraps body to record it for debug output, returning nil
+// when debug output is off.
+func (c *Client) captureResponse(body *io.ReadCloser) *debugCapture {
+	if !c.debug.enabled() {
+		return nil
+	}
+	capture := &debugCapture{ReadCloser: *body}
+	*body = capture
+	return capture
+}
+
+// debugResponse writes the debug line for a response or failed request.
+func (c *Client) debugResponse(method, path, clientRequestID string, status int, elapsed time.Duration, capture *debugCapture, err error) {
+	if !c.debug.enabled() {
+		return
+	}
+	elapsed = elapsed.Round(time.Millisecond)
+	switch {
+	case status == 0:
+		c.debugf("<-- error %s %s id=%s %v: %v", method, path, clientRequestID, elapsed, err)
+	case capture != nil:
+		c.debugf("<-- %d %s %s id=%s %v body=%s", status, method, path, clientRequestID, elapsed, c.debugBody(capture.buf.Bytes(), capture.total))
+	default:
+		c.debugf("<-- %d %s %s id=%s %v", status, method, path, clientRequestID, elapsed)
+	}
+}
@@ -0,0 +1,149 @@
+package actorhub
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DialFunc dials a network connection, like net.Dialer.DialContext.
+type DialFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// WithDialer sets the function used to open connections to the API, for
+// example to route through a proxy or bind to a particular interface. It
+// applies to the client's own transport, or to a custom *http.Transport set
+// with WithHTTPClient; other transports are left as they are.
+func WithDialer(dial DialFunc) ClientOption {
+	return func(c *Client) {
+		c.dial = dial
+	}
+}
+
+// WithDNSCache caches DNS lookups for the API host for ttl, so high request
+// rates don't overload resolvers. Entries are refreshed once they expire and
+// dropped as soon as no cached address accepts a connection, so failover to
+// new addresses is picked up without waiting for the ttl. If a refresh fails,
+// the previous addresses are used until a lookup succeeds.
+func WithDNSCache(ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.dnsCacheTTL = ttl
+	}
+}
+
+// configureDialer installs the custom dialer and DNS cache, if any, on a copy
+// of the client's transport.
+func (c *Client) configureDialer() {
+	if c.dial == nil && c.dnsCacheTTL <= 0 {
+		return
+	}
+
+	var transport *http.Transport
+	switch t := c.httpClient.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return
+	}
+
+	dial := c.dial
+	if dial == nil {
+		dial = transport.DialContext
+	}
+	if dial == nil {
+		dial = (&net.Dialer{}).DialContext
+	}
+	if c.dnsCacheTTL > 0 {
+		dial = newDNSCache(c.dnsCacheTTL, dial).dial
+	}
+	transport.DialContext = dial
+
+	httpClient := *c.httpClient
+	httpClient.Transport = transport
+	c.httpClient = &httpClient
+}
+
+// dnsCache resolves hosts through a cache before dialing.
+type dnsCache struct {
+	ttl      time.Duration
+	next     DialFunc
+	resolver *net.Resolver
+
+	mu      sync.Mutex
+	entries map[string]dnsEntry
+}
+
+type dnsEntry struct {
+	addrs   []string
+	expires time.Time
+}
+
+func newDNSCache(ttl time.Duration, next DialFunc) *dnsCache {
+	return &dnsCache{
+		ttl:      ttl,
+		next:     next,
+		resolver: net.DefaultResolver,
+		entries:  make(map[string]dnsEntry),
+	}
+}
+
+// dial connects to the first cached address of addr's host that accepts.
+func (d *dnsCache) dial(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil || net.ParseIP(host) != nil {
+		return d.next(ctx, network, addr)
+	}
+
+	addrs, err := d.lookup(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+	for _, ip := range addrs {
+		conn, err := d.next(ctx, network, net.JoinHostPort(ip, port))
+		if err == nil {
+			return conn, nil
+		}
+		lastErr = err
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	// The cached addresses may be stale after a failover; resolve again next time.
+	d.mu.Lock()
+	delete(d.entries, host)
+	d.mu.Unlock()
+	return nil, lastErr
+}
+
+// lookup returns the addresses of host, resolving it if the cache entry is
+// missing or expired.
+func (d *dnsCache) lookup(ctx context.Context, host string) ([]string, error) {
+	d.mu.Lock()
+	entry, ok := d.entries[host]
+	d.mu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.addrs, nil
+	}
+
+	addrs, err := d.resolver.LookupHost(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		if ok {
+			return entry.addrs, nil
+		}
+		if err == nil {
+			err = &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+		}
+		return nil, err
+	}
+
+	d.mu.Lock()
+	d.entries[host] = dnsEntry{addrs: addrs, expires: time.Now().Add(d.ttl)}
+	d.mu.Unlock()
+	return addrs, nil
+}
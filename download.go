@@ -0,0 +1,271 @@
+package actorhub
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DownloadOption configures Download and the methods built on it.
+type DownloadOption func(*downloadOptions)
+
+type downloadOptions struct {
+	progress func(written, total int64)
+}
+
+// WithProgress calls fn as data is written, with the bytes written so far
+// and the total size, or -1 if the server did not report it. fn is called
+// from the downloading goroutine and should return quickly.
+func WithProgress(fn func(written, total int64)) DownloadOption {
+	return func(o *downloadOptions) {
+		o.progress = fn
+	}
+}
+
+// Download writes the resource at rawURL to w and returns the number of
+// bytes written. rawURL is either an API path such as
+// "/api/v1/billing/invoices/inv_1/receipt.pdf", sent with the client's
+// credentials, or an absolute URL such as a signed Actor Pack URL, sent
+// without them unless it points at the client's base URL.
+//
+// A transfer interrupted by a network failure or server error is resumed
+// with a Range request from the last byte written, retrying with backoff up
+// to the client's retry limit without progress. If the resource changed in
+// the meantime, or the server ignores the Range and sends a resource without
+// an ETag or Last-Modified to compare, Download fails rather than splice two
+// versions; the data written to w must then be discarded. With WithResponseVerification the
+// whole body is needed for the signature, so API paths are fetched in one
+// request without resumption.
+func (c *Client) Download(ctx context.Context, rawURL string, w io.Writer, opts ...DownloadOption) (int64, error) {
+	var o downloadOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	authenticated := strings.HasPrefix(rawURL, "/") || strings.HasPrefix(rawURL, c.baseURL+"/")
+	path := strings.TrimPrefix(rawURL, c.baseURL)
+	dw := &downloadWriter{w: w, total: -1, progress: o.progress}
+
+	if authenticated && c.verifyResponses {
+		err := c.doRequest(ctx, http.MethodGet, path, nil, dw)
+		return dw.written, err
+	}
+
+	// Signed URLs elsewhere are tracked under one endpoint name rather
+	// than by their paths.
+	if !authenticated {
+		path = "/download"
+	}
+	ctx, clientRequestID := ensureClientRequestID(ctx)
+	ctx, call := c.startCall(ctx, http.MethodGet, path)
+	err := c.download(ctx, rawURL, authenticated, dw)
+	c.finishCall(call, err)
+	return dw.written, attachClientRequestID(ctx, err, clientRequestID)
+}
+
+// download runs the attempts of a Download.
+func (c *Client) download(ctx context.Context, rawURL string, authenticated bool, dw *downloadWriter) error {
+	reqURL := rawURL
+	if strings.HasPrefix(rawURL, "/") {
+		reqURL = c.baseURL + rawURL
+	}
+
+	var validator string // ETag or Last-Modified of the first response
+	failures := 0
+	for {
+		start := dw.written
+		err := c.downloadAttempt(ctx, reqURL, authenticated, dw, &validator)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil || !retryableDownloadError(err) {
+			return err
+		}
+
+		if dw.written > start {
+			failures = 0
+		}
+		failures++
+		if failures >= c.maxRetries {
+			return err
+		}
+		if call := callStatsFrom(ctx); call != nil {
+			call.retries++
+		}
+
+		wait, ok := retryWait(ctx, failures, err)
+		if !ok {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// errDownloadChanged reports that a resource changed while it was being
+// downloaded, or that the server sent it whole again without a validator
+// proving it is unchanged.
+var errDownloadChanged = errors.New("actorhub: resource changed during download")
+
+// downloadAttempt requests the rest of the resource from dw.written and
+// copies it to dw.
+func (c *Client) downloadAttempt(ctx context.Context, reqURL string, authenticated bool, dw *downloadWriter, validator *string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	// Ranges count bytes of the stored representation, so ask for it as is.
+	req.Header.Set("Accept-Encoding", "identity")
+	if dw.written > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", dw.written))
+		if *validator != "" {
+			req.Header.Set("If-Range", *validator)
+		}
+	}
+	if authenticated {
+		req.Header.Set("X-API-Key", c.apiKey)
+		if id := ClientRequestID(ctx); id != "" {
+			req.Header.Set(clientRequestIDHeader, id)
+		}
+		c.signRequest(req, nil)
+	}
+
+	resp, err := c.doHTTP(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+	if authenticated {
+		c.recordResponse(ctx, resp)
+	}
+
+	if resp.StatusCode >= 400 {
+		respBody, _ := io.ReadAll(resp.Body)
+		// Error bodies may echo request data, as in handleResponse.
+		respBody = []byte(c.Redact(string(respBody)))
+		return responseError(resp.StatusCode, resp.Header, respBody, resp.Header.Get("X-Request-ID"))
+	}
+
+	current := resp.Header.Get("ETag")
+	if current == "" {
+		current = resp.Header.Get("Last-Modified")
+	}
+	body := io.Reader(resp.Body)
+	switch {
+	case dw.written == 0:
+		*validator = current
+		if resp.ContentLength >= 0 {
+			dw.total = resp.ContentLength
+		}
+	case resp.StatusCode == http.StatusPartialContent:
+		if !strings.HasPrefix(resp.Header.Get("Content-Range"), fmt.Sprintf("bytes %d-", dw.written)) {
+			return fmt.Errorf("actorhub: unexpected Content-Range %q", resp.Header.Get("Content-Range"))
+		}
+	default:
+		// The server sent the whole resource again: skip what was already
+		// written if it is still the same resource. Without a validator
+		// there is no telling, and w cannot be rewound to start over.
+		if *validator == "" || current != *validator {
+			return errDownloadChanged
+		}
+		if _, err := io.CopyN(io.Discard, body, dw.written); err != nil {
+			return fmt.Errorf("failed to read response body: %w", err)
+		}
+	}
+
+	if _, err := io.Copy(dw, body); err != nil {
+		var writeErr *downloadWriteError
+		if errors.As(err, &writeErr) {
+			return fmt.Errorf("failed to write response body: %w", err)
+		}
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	if dw.total >= 0 && dw.written < dw.total {
+		return fmt.Errorf("failed to read response body: %w", io.ErrUnexpectedEOF)
+	}
+	return nil
+}
+
+// retryableDownloadError reports whether a failed download attempt is worth
+// resuming: after a network failure, a server error, or rate limiting.
+func retryableDownloadError(err error) bool {
+	if errors.Is(err, errDownloadChanged) {
+		return false
+	}
+	var writeErr *downloadWriteError
+	if errors.As(err, &writeErr) {
+		return false
+	}
+	var e apiError
+	if !errors.As(err, &e) {
+		return true
+	}
+	var serverErr *ServerError
+	var rateLimitErr *RateLimitError
+	return errors.As(err, &serverErr) || errors.As(err, &rateLimitErr)
+}
+
+// downloadWriter counts the bytes written to w and reports progress.
+type downloadWriter struct {
+	w        io.Writer
+	written  int64
+	total    int64
+	progress func(written, total int64)
+}
+
+// downloadWriteError marks a failure of the destination writer, which
+// retrying cannot fix.
+type downloadWriteError struct {
+	err error
+}
+
+func (e *downloadWriteError) Error() string {
+	return e.err.Error()
+}
+
+func (d *downloadWriter) Write(p []byte) (int, error) {
+	n, err := d.w.Write(p)
+	d.written += int64(n)
+	if d.progress != nil && n > 0 {
+		d.progress(d.written, d.total)
+	}
+	if err != nil {
+		return n, &downloadWriteError{err: err}
+	}
+	return n, nil
+}
+
+// DownloadActorPack downloads the Actor Pack's model weights described by
+// req, see GetActorPackDownload, to w. The data is checked against the
+// published SHA-256 digest; on error the data written to w must be
+// discarded.
+func (c *Client) DownloadActorPack(ctx context.Context, packID string, req *ActorPackDownloadRequest, w io.Writer, opts ...DownloadOption) (*ActorPackDownload, error) {
+	download, err := c.GetActorPackDownload(ctx, packID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	h := sha256.New()
+	n, err := c.Download(ctx, download.URL, io.MultiWriter(w, h), opts...)
+	if err != nil {
+		return nil, err
+	}
+	if download.SizeBytes > 0 && n != download.SizeBytes {
+		return nil, fmt.Errorf("actorhub: Actor Pack download is %d bytes, expected %d", n, download.SizeBytes)
+	}
+	if download.SHA256 != "" && hex.EncodeToString(h.Sum(nil)) != strings.ToLower(download.SHA256) {
+		return nil, errors.New("actorhub: Actor Pack download digest mismatch")
+	}
+
+	return download, nil
+}
@@ -0,0 +1,95 @@
+package actorhub
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// flakyDownloadServer serves content, dropping the connection partway
+// through the first response. A server honoring ranges resumes with 206;
+// otherwise it sends the whole content again.
+func flakyDownloadServer(content []byte, etag string, ranges bool, requests *[]*http.Request) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r)
+		if etag != "" {
+			w.Header().Set("ETag", etag)
+		}
+		body := content
+		if rng := r.Header.Get("Range"); ranges && rng != "" && r.Header.Get("If-Range") == etag {
+			start, _ := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(rng, "bytes="), "-"))
+			w.Header().Set("Content-Range", "bytes "+strconv.Itoa(start)+"-"+strconv.Itoa(len(content)-1)+"/"+strconv.Itoa(len(content)))
+			w.Header().Set("Content-Length", strconv.Itoa(len(content)-start))
+			w.WriteHeader(http.StatusPartialContent)
+			w.Write(content[start:])
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		if len(*requests) == 1 {
+			w.Write(body[:len(body)/2]) // the handler returns short: the connection drops
+			return
+		}
+		w.Write(body)
+	}))
+}
+
+func TestDownloadResumesWithRange(t *testing.T) {
+	content := bytes.Repeat([]byte("abcdefghij"), 100)
+	var requests []*http.Request
+	srv := flakyDownloadServer(content, `"v1"`, true, &requests)
+	defer srv.Close()
+
+	c := NewClient("key", WithBaseURL(srv.URL), WithMaxRetries(3), WithRequestSigning("secret"))
+	var buf bytes.Buffer
+	n, err := c.Download(context.Background(), "/api/v1/files/f_1", &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(len(content)) || !bytes.Equal(buf.Bytes(), content) {
+		t.Errorf("downloaded %d bytes that differ from the %d served", n, len(content))
+	}
+	if len(requests) != 2 || requests[1].Header.Get("Range") != "bytes=500-" {
+		t.Fatalf("made %d requests, want a second with Range bytes=500-", len(requests))
+	}
+	for i, r := range requests {
+		if r.Header.Get("X-API-Key") != "key" || r.Header.Get(requestSignatureHeader) == "" {
+			t.Errorf("request %d is not authenticated and signed", i)
+		}
+	}
+}
+
+func TestDownloadWithoutValidatorDoesNotSplice(t *testing.T) {
+	content := bytes.Repeat([]byte("abcdefghij"), 100)
+	var requests []*http.Request
+	srv := flakyDownloadServer(content, "", false, &requests)
+	defer srv.Close()
+
+	c := NewClient("key", WithBaseURL(srv.URL), WithMaxRetries(3))
+	var buf bytes.Buffer
+	n, err := c.Download(context.Background(), "/api/v1/files/f_1", &buf)
+	if !errors.Is(err, errDownloadChanged) {
+		t.Errorf("err = %v, want errDownloadChanged", err)
+	}
+	if n != int64(len(content)/2) {
+		t.Errorf("wrote %d bytes, want only the %d before the drop", n, len(content)/2)
+	}
+}
+
+func TestDownloadRedactsErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"detail":"not ready for key_12345678"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("key_12345678", WithBaseURL(srv.URL), WithMaxRetries(1))
+	_, err := c.Download(context.Background(), "/api/v1/files/f_1", &bytes.Buffer{})
+	if err == nil || strings.Contains(err.Error(), "key_12345678") {
+		t.Errorf("err = %v, want an error without the API key", err)
+	}
+}
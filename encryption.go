@@ -0,0 +1,363 @@
+package actorhub
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// encryptionKeysPath is the location of ActorHub's public encryption keys.
+	encryptionKeysPath = "/.well-known/encryption-keys.json"
+
+	// encryptionKeyTTL is how long a fetched encryption key is used before
+	// the published keys are checked again.
+	encryptionKeyTTL = time.Hour
+
+	// encryptionHeader announces encrypted fields in a request body.
+	encryptionHeader = "X-Payload-Encryption"
+
+	// encryptionScheme names the hybrid scheme used for payload encryption.
+	encryptionScheme = "ECDH-ES+A256GCM"
+
+	// errCodeEncryptionKeyRetired is the error code the API returns for a
+	// payload encrypted to a key it no longer accepts.
+	errCodeEncryptionKeyRetired = "encryption_key_retired"
+)
+
+// ErrEncryptionUnsupported is returned when payload encryption is enabled
+// and a method would send media as a file, such as a multipart upload,
+// UploadMedia, or UploadResumable, which cannot be encrypted. Send the
+// media as base64 in a JSON request instead.
+var ErrEncryptionUnsupported = errors.New("actorhub: media uploads cannot be sent with payload encryption")
+
+// encryptedFields are the request fields holding biometric payloads.
+var encryptedFields = map[string]bool{
+	"image_base64":   true,
+	"audio_base64":   true,
+	"face_embedding": true,
+}
+
+// WithPayloadEncryption encrypts the image, audio, and face embedding fields
+// of JSON request bodies to pubKey before they are sent, so biometric data
+// stays unreadable to TLS-terminating intermediaries. Pass nil to use
+// ActorHub's published encryption key, which the client fetches when first
+// needed, rechecks hourly, and refetches at once if the API reports that the
+// key was rotated out, retrying the request under the new key. The published
+// key set must carry a valid X-Signature from ActorHub's JWKS signing keys,
+// as with WithResponseVerification, so an intermediary cannot substitute
+// its own key.
+//
+// Media sent as files rather than JSON fields cannot be encrypted: while
+// encryption is enabled, multipart uploads such as video verification with
+// VideoVerifyRequest.Video, UploadMedia, and UploadResumable fail with
+// ErrEncryptionUnsupported instead of sending the data in plaintext.
+//
+// Each field value is replaced with an envelope object:
+//
+//	{"alg": "ECDH-ES+A256GCM", "kid": ..., "epk": ..., "iv": ..., "ct": ...}
+//
+// epk is an ephemeral P-256 public key; the AES-256-GCM key is derived from
+// the ECDH shared secret with HKDF-SHA256, and ct encrypts the field's JSON
+// value with the field name as additional data. kid is the key's ID from the
+// published key set, or its RFC 7638 thumbprint for a pinned key.
+func WithPayloadEncryption(pubKey *ecdh.PublicKey) ClientOption {
+	return func(c *Client) {
+		keys := &encryptionKeys{}
+		if pubKey != nil {
+			keys.pinned = true
+			keys.id = jwkThumbprint(pubKey)
+			keys.key = pubKey
+		}
+		c.encryption = keys
+	}
+}
+
+// encryptionKeys holds the key that payloads are encrypted to. Like
+// jwksCache, the mutex is not held during a fetch; refreshing is set while
+// one is in flight so concurrent callers wait for it.
+type encryptionKeys struct {
+	pinned bool
+
+	mu         sync.Mutex
+	id         string
+	key        *ecdh.PublicKey
+	fetchedAt  time.Time
+	refreshing chan struct{}
+}
+
+// encryptionKey returns the key to encrypt to, fetching the published keys if
+// none is cached or the cached one is due for a recheck.
+func (c *Client) encryptionKey(ctx context.Context) (string, *ecdh.PublicKey, error) {
+	k := c.encryption
+	for {
+		k.mu.Lock()
+		if k.key != nil && (k.pinned || time.Since(k.fetchedAt) < encryptionKeyTTL) {
+			id, key := k.id, k.key
+			k.mu.Unlock()
+			return id, key, nil
+		}
+		if wait := k.refreshing; wait != nil {
+			k.mu.Unlock()
+			select {
+			case <-wait:
+				continue
+			case <-ctx.Done():
+				return "", nil, ctx.Err()
+			}
+		}
+		done := make(chan struct{})
+		k.refreshing = done
+		k.mu.Unlock()
+
+		id, key, err := c.fetchEncryptionKey(ctx)
+
+		k.mu.Lock()
+		k.refreshing = nil
+		if err == nil {
+			k.id, k.key, k.fetchedAt = id, key, time.Now()
+		}
+		k.mu.Unlock()
+		close(done)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+}
+
+// keyRetired reports whether err says the request was encrypted to a
+// retired key, dropping that key so the next attempt fetches its successor.
+// It is always false for a pinned key.
+func (k *encryptionKeys) keyRetired(err error) bool {
+	if k == nil || k.pinned {
+		return false
+	}
+	var e apiError
+	if !errors.As(err, &e) || e.apiError().ResponseData["code"] != errCodeEncryptionKeyRetired {
+		return false
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.key = nil
+	return true
+}
+
+// fetchEncryptionKey downloads the published encryption keys, checks their
+// signature, and returns the first usable one, which is the one ActorHub
+// prefers.
+func (c *Client) fetchEncryptionKey(ctx context.Context) (string, *ecdh.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+encryptionKeysPath, nil)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.doHTTP(req)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to fetch encryption keys: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", nil, fmt.Errorf("failed to fetch encryption keys: HTTP %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to fetch encryption keys: %w", err)
+	}
+	if err := c.verifyResponseSignature(ctx, resp.Header, body); err != nil {
+		return "", nil, fmt.Errorf("failed to fetch encryption keys: %w", err)
+	}
+
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Crv string `json:"crv"`
+			Kid string `json:"kid"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal(body, &set); err != nil {
+		return "", nil, fmt.Errorf("failed to decode encryption keys: %w", err)
+	}
+
+	for _, k := range set.Keys {
+		if k.Kty != "EC" || k.Crv != "P-256" || k.Kid == "" {
+			continue
+		}
+		x, errX := base64.RawURLEncoding.DecodeString(k.X)
+		y, errY := base64.RawURLEncoding.DecodeString(k.Y)
+		if errX != nil || errY != nil || len(x) != 32 || len(y) != 32 {
+			continue
+		}
+		key, err := ecdh.P256().NewPublicKey(append(append([]byte{4}, x...), y...))
+		if err != nil {
+			continue
+		}
+		return k.Kid, key, nil
+	}
+	return "", nil, errors.New("actorhub: no usable encryption key published")
+}
+
+// jwkThumbprint returns the RFC 7638 thumbprint of a P-256 public key.
+func jwkThumbprint(key *ecdh.PublicKey) string {
+	point := key.Bytes() // uncompressed: 0x04 || X || Y
+	canonical := fmt.Sprintf(`{"crv":"P-256","kty":"EC","x":"%s","y":"%s"}`,
+		base64.RawURLEncoding.EncodeToString(point[1:33]),
+		base64.RawURLEncoding.EncodeToString(point[33:]))
+	sum := sha256.Sum256([]byte(canonical))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// encryptedEnvelope replaces the value of an encrypted field.
+type encryptedEnvelope struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	Epk string `json:"epk"`
+	IV  string `json:"iv"`
+	Ct  string `json:"ct"`
+}
+
+// encryptPayload encrypts the biometric fields of a JSON body, reporting
+// false if it has none.
+func (c *Client) encryptPayload(ctx context.Context, body []byte) ([]byte, bool, error) {
+	var doc interface{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return nil, false, fmt.Errorf("failed to encrypt request body: %w", err)
+	}
+	if !hasEncryptedField(doc) {
+		return body, false, nil
+	}
+
+	kid, key, err := c.encryptionKey(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	ephemeral, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, false, err
+	}
+	shared, err := ephemeral.ECDH(key)
+	if err != nil {
+		return nil, false, err
+	}
+	epk := ephemeral.PublicKey().Bytes()
+	block, err := aes.NewCipher(hkdfSHA256(shared, append([]byte(encryptionScheme+"\x00"+kid+"\x00"), epk...), 32))
+	if err != nil {
+		return nil, false, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, false, err
+	}
+
+	seal := func(field string, value interface{}) (interface{}, error) {
+		plaintext, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		iv := make([]byte, aead.NonceSize())
+		if _, err := rand.Read(iv); err != nil {
+			return nil, err
+		}
+		return encryptedEnvelope{
+			Alg: encryptionScheme,
+			Kid: kid,
+			Epk: base64.RawURLEncoding.EncodeToString(epk),
+			IV:  base64.RawURLEncoding.EncodeToString(iv),
+			Ct:  base64.RawURLEncoding.EncodeToString(aead.Seal(nil, iv, plaintext, []byte(field))),
+		}, nil
+	}
+	if doc, err = sealFields(doc, seal); err != nil {
+		return nil, false, fmt.Errorf("failed to encrypt request body: %w", err)
+	}
+	encrypted, err := json.Marshal(doc)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to encrypt request body: %w", err)
+	}
+	return encrypted, true, nil
+}
+
+// hasEncryptedField reports whether a decoded JSON value holds a non-empty
+// field that must be encrypted.
+func hasEncryptedField(v interface{}) bool {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for name, field := range v {
+			if encryptedFields[name] && field != nil {
+				return true
+			}
+			if hasEncryptedField(field) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, elem := range v {
+			if hasEncryptedField(elem) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sealFields replaces each field that must be encrypted with seal's result.
+func sealFields(v interface{}, seal func(field string, value interface{}) (interface{}, error)) (interface{}, error) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for name, field := range v {
+			var err error
+			if encryptedFields[name] && field != nil {
+				v[name], err = seal(name, field)
+			} else {
+				v[name], err = sealFields(field, seal)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	case []interface{}:
+		for i, elem := range v {
+			var err error
+			if v[i], err = sealFields(elem, seal); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return v, nil
+}
+
+// hkdfSHA256 derives a key of length n from secret with HKDF-SHA256 and an
+// empty salt (RFC 5869).
+func hkdfSHA256(secret, info []byte, n int) []byte {
+	extract := hmac.New(sha256.New, make([]byte, sha256.Size))
+	extract.Write(secret)
+	prk := extract.Sum(nil)
+
+	var out, prev []byte
+	for counter := byte(1); len(out) < n; counter++ {
+		expand := hmac.New(sha256.New, prk)
+		expand.Write(prev)
+		expand.Write(info)
+		expand.Write([]byte{counter})
+		prev = expand.Sum(nil)
+		out = append(out, prev...)
+	}
+	return out[:n]
+}
@@ -0,0 +1,194 @@
+package actorhub
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// encryptionServer publishes a signed encryption key set and decrypts the
+// image_base64 field of verify requests. Rotating replaces the published
+// key and rejects requests encrypted to the old one as retired.
+type encryptionServer struct {
+	t        *testing.T
+	signing  *ecdsa.PrivateKey
+	unsigned bool
+
+	mu      sync.Mutex
+	kid     string
+	key     *ecdh.PrivateKey
+	images  []string
+	fetches int
+}
+
+func newEncryptionServer(t *testing.T) *encryptionServer {
+	t.Helper()
+	signing, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &encryptionServer{t: t, signing: signing}
+	s.rotate("enc_1")
+	return s
+}
+
+func (s *encryptionServer) rotate(kid string) {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.kid, s.key = kid, key
+}
+
+func (s *encryptionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch r.URL.Path {
+	case jwksPath:
+		fmt.Fprintf(w, `{"keys":[{"kty":"EC","crv":"P-256","kid":"sig_1","x":"%s","y":"%s"}]}`,
+			base64.RawURLEncoding.EncodeToString(s.signing.X.FillBytes(make([]byte, 32))),
+			base64.RawURLEncoding.EncodeToString(s.signing.Y.FillBytes(make([]byte, 32))))
+	case encryptionKeysPath:
+		s.fetches++
+		point := s.key.PublicKey().Bytes()
+		body := fmt.Sprintf(`{"keys":[{"kty":"EC","crv":"P-256","kid":"%s","x":"%s","y":"%s"}]}`, s.kid,
+			base64.RawURLEncoding.EncodeToString(point[1:33]),
+			base64.RawURLEncoding.EncodeToString(point[33:]))
+		if !s.unsigned {
+			digest := sha256.Sum256([]byte(body))
+			sr, ss, err := ecdsa.Sign(rand.Reader, s.signing, digest[:])
+			if err != nil {
+				s.t.Error(err)
+			}
+			w.Header().Set("X-Signature", base64.RawURLEncoding.EncodeToString(append(sr.FillBytes(make([]byte, 32)), ss.FillBytes(make([]byte, 32))...)))
+			w.Header().Set("X-Signature-Key-ID", "sig_1")
+		}
+		w.Write([]byte(body))
+	default:
+		var req struct {
+			ImageBase64 encryptedEnvelope `json:"image_base64"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			s.t.Errorf("decoding request: %v", err)
+		}
+		if req.ImageBase64.Kid != s.kid {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"detail":"key retired","code":"encryption_key_retired"}`))
+			return
+		}
+		var image string
+		if err := json.Unmarshal(s.decrypt(req.ImageBase64, "image_base64"), &image); err != nil {
+			s.t.Errorf("decrypted image: %v", err)
+		}
+		s.images = append(s.images, image)
+		w.Write([]byte(`{"protected":false,"faces_detected":0,"identities":[]}`))
+	}
+}
+
+func (s *encryptionServer) decrypt(env encryptedEnvelope, field string) []byte {
+	epk, _ := base64.RawURLEncoding.DecodeString(env.Epk)
+	iv, _ := base64.RawURLEncoding.DecodeString(env.IV)
+	ct, _ := base64.RawURLEncoding.DecodeString(env.Ct)
+	ephemeral, err := ecdh.P256().NewPublicKey(epk)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	shared, err := s.key.ECDH(ephemeral)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	block, _ := aes.NewCipher(hkdfSHA256(shared, append([]byte(env.Alg+"\x00"+env.Kid+"\x00"), epk...), 32))
+	aead, _ := cipher.NewGCM(block)
+	plaintext, err := aead.Open(nil, iv, ct, []byte(field))
+	if err != nil {
+		s.t.Fatalf("decrypting %s: %v", field, err)
+	}
+	return plaintext
+}
+
+func TestPayloadEncryptionRoundTripAndRotation(t *testing.T) {
+	s := newEncryptionServer(t)
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	c := NewClient("key", WithBaseURL(srv.URL), WithMaxRetries(1), WithPayloadEncryption(nil))
+	ctx := context.Background()
+	if _, err := c.Verify(ctx, &VerifyRequest{ImageBase64: "aW1hZ2Ux"}); err != nil {
+		t.Fatal(err)
+	}
+	s.rotate("enc_2")
+	if _, err := c.Verify(ctx, &VerifyRequest{ImageBase64: "aW1hZ2Uy"}); err != nil {
+		t.Fatalf("after rotation: %v", err)
+	}
+
+	if len(s.images) != 2 || s.images[0] != "aW1hZ2Ux" || s.images[1] != "aW1hZ2Uy" {
+		t.Errorf("server decrypted %q, want both images", s.images)
+	}
+	if s.fetches != 2 {
+		t.Errorf("key set fetched %d times, want 2", s.fetches)
+	}
+}
+
+func TestPayloadEncryptionRejectsUnsignedKeys(t *testing.T) {
+	s := newEncryptionServer(t)
+	s.unsigned = true
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	c := NewClient("key", WithBaseURL(srv.URL), WithMaxRetries(1), WithPayloadEncryption(nil))
+	_, err := c.Verify(context.Background(), &VerifyRequest{ImageBase64: "aW1hZ2Ux"})
+	if !errors.Is(err, ErrInvalidResponseSignature) {
+		t.Errorf("err = %v, want ErrInvalidResponseSignature", err)
+	}
+	if len(s.images) != 0 {
+		t.Error("payload was sent under an unsigned key")
+	}
+}
+
+func TestPayloadEncryptionRefusesFileUploads(t *testing.T) {
+	sent := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = true
+	}))
+	defer srv.Close()
+
+	pinned, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewClient("key", WithBaseURL(srv.URL), WithMaxRetries(1), WithPayloadEncryption(pinned.PublicKey()))
+	ctx := context.Background()
+	video := []byte("video bytes")
+
+	_, err = c.VerifyVideo(ctx, &VideoVerifyRequest{Video: bytes.NewReader(video)})
+	if !errors.Is(err, ErrEncryptionUnsupported) {
+		t.Errorf("VerifyVideo: err = %v, want ErrEncryptionUnsupported", err)
+	}
+	_, err = c.UploadMedia(ctx, "video/mp4", bytes.NewReader(video), int64(len(video)))
+	if !errors.Is(err, ErrEncryptionUnsupported) {
+		t.Errorf("UploadMedia: err = %v, want ErrEncryptionUnsupported", err)
+	}
+	_, err = c.UploadResumable(ctx, bytes.NewReader(video), int64(len(video)), nil)
+	if !errors.Is(err, ErrEncryptionUnsupported) {
+		t.Errorf("UploadResumable: err = %v, want ErrEncryptionUnsupported", err)
+	}
+	if sent {
+		t.Error("media reached the server unencrypted")
+	}
+}
@@ -0,0 +1,144 @@
+package actorhub
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Enum types decode any string the API sends. Values that match a known
+// constant apart from case are normalized to it; anything else is preserved
+// as-is rather than rejected, so a value added by the API does not break
+// decoding. Check IsKnown before relying on a switch over the constants.
+
+var (
+	knownTrainingStatuses = []TrainingStatus{
+		TrainingStatusQueued, TrainingStatusProcessing, TrainingStatusCompleted, TrainingStatusFailed,
+	}
+	knownProtectionLevels = []ProtectionLevel{
+		ProtectionLevelFree, ProtectionLevelPro, ProtectionLevelEnterprise,
+	}
+	knownLicenseTypes = []LicenseType{
+		LicenseTypeStandard, LicenseTypeExtended, LicenseTypeExclusive,
+	}
+	knownUsageTypes = []UsageType{
+		UsageTypePersonal, UsageTypeEditorial, UsageTypeCommercial, UsageTypeEducational,
+	}
+	knownPlatforms = []Platform{
+		PlatformRunway, PlatformPika, PlatformKling, PlatformSora, PlatformOpenAI, PlatformStability, PlatformComfyUI,
+	}
+	knownIntendedUses = []IntendedUse{
+		IntendedUseImage, IntendedUseVideo, IntendedUseAudio, IntendedUseAITraining,
+	}
+)
+
+// IsKnown reports whether s is one of the TrainingStatus constants.
+func (s TrainingStatus) IsKnown() bool {
+	return isKnown(s, knownTrainingStatuses)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *TrainingStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, s, knownTrainingStatuses)
+}
+
+// IsKnown reports whether l is one of the ProtectionLevel constants.
+func (l ProtectionLevel) IsKnown() bool {
+	return isKnown(l, knownProtectionLevels)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (l *ProtectionLevel) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, l, knownProtectionLevels)
+}
+
+// IsKnown reports whether t is one of the LicenseType constants.
+func (t LicenseType) IsKnown() bool {
+	return isKnown(t, knownLicenseTypes)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *LicenseType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, t, knownLicenseTypes)
+}
+
+// IsKnown reports whether t is one of the UsageType constants.
+func (t UsageType) IsKnown() bool {
+	return isKnown(t, knownUsageTypes)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *UsageType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, t, knownUsageTypes)
+}
+
+// IsKnown reports whether p is one of the Platform constants.
+func (p Platform) IsKnown() bool {
+	return isKnown(p, knownPlatforms)
+}
+
+// IsKnown reports whether u is one of the IntendedUse constants.
+func (u IntendedUse) IsKnown() bool {
+	return isKnown(u, knownIntendedUses)
+}
+
+// WithPlatforms lets consent checks name platforms added to the API after
+// this SDK version, which are otherwise rejected as unknown.
+func WithPlatforms(platforms ...Platform) ClientOption {
+	return func(c *Client) {
+		c.extraPlatforms = append(c.extraPlatforms, platforms...)
+	}
+}
+
+// validatePlatform checks that a consent check names a platform and intended
+// use the client knows, so a typo cannot silently change the decision.
+func (c *Client) validatePlatform(platform Platform, intendedUse IntendedUse) error {
+	if !platform.IsKnown() && !isKnown(platform, c.extraPlatforms) {
+		return NewValidationError(fmt.Sprintf("Unknown platform %q", platform), map[string]interface{}{
+			"platform": fmt.Sprintf("must be one of %s", joinEnum(append(knownPlatforms, c.extraPlatforms...))),
+		}, "")
+	}
+	if !intendedUse.IsKnown() {
+		return NewValidationError(fmt.Sprintf("Unknown intended use %q", intendedUse), map[string]interface{}{
+			"intended_use": fmt.Sprintf("must be one of %s", joinEnum(knownIntendedUses)),
+		}, "")
+	}
+	return nil
+}
+
+func joinEnum[T ~string](values []T) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = string(v)
+	}
+	return strings.Join(s, ", ")
+}
+
+func isKnown[T ~string](v T, known []T) bool {
+	for _, k := range known {
+		if v == k {
+			return true
+		}
+	}
+	return false
+}
+
+// unmarshalEnum decodes a JSON string or null into v, normalizing the case
+// of known values.
+func unmarshalEnum[T ~string](data []byte, v *T, known []T) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == nil {
+		return nil
+	}
+	for _, k := range known {
+		if strings.EqualFold(*s, string(k)) {
+			*v = k
+			return nil
+		}
+	}
+	*v = T(*s)
+	return nil
+}
@@ -1,15 +1,24 @@
 package actorhub
 
 import (
+	"errors"
 	"fmt"
+	"time"
 )
 
+// ErrCheckoutExpired is returned when a checkout session expires before payment completes.
+var ErrCheckoutExpired = errors.New("actorhub: checkout session expired")
+
 // ActorHubError is the base error type for ActorHub SDK errors.
 type ActorHubError struct {
 	Message      string
 	StatusCode   int
 	ResponseData map[string]interface{}
 	RequestID    string
+
+	// ClientRequestID is the X-Client-Request-ID sent with the request, set
+	// even when the server did not return a request ID.
+	ClientRequestID string
 }
 
 func (e *ActorHubError) Error() string {
@@ -20,6 +29,9 @@ func (e *ActorHubError) Error() string {
 	if e.RequestID != "" {
 		parts = fmt.Sprintf("%s [Request ID: %s]", parts, e.RequestID)
 	}
+	if e.ClientRequestID != "" {
+		parts = fmt.Sprintf("%s [Client Request ID: %s]", parts, e.ClientRequestID)
+	}
 	return parts
 }
 
@@ -42,10 +54,88 @@ func NewAuthenticationError(message string, requestID string) *AuthenticationErr
 	}
 }
 
+// ForbiddenError is raised when the API key is valid but not allowed to make
+// the request, typically because it lacks a scope.
+type ForbiddenError struct {
+	ActorHubError
+	RequiredScope string // scope the key needs, if reported
+}
+
+// NewForbiddenError creates a new ForbiddenError.
+func NewForbiddenError(message string, responseData map[string]interface{}, requestID string) *ForbiddenError {
+	if message == "" {
+		message = "API key is not allowed to perform this request"
+	}
+	scope, _ := responseData["required_scope"].(string)
+	return &ForbiddenError{
+		ActorHubError: ActorHubError{
+			Message:      message,
+			StatusCode:   403,
+			ResponseData: responseData,
+			RequestID:    requestID,
+		},
+		RequiredScope: scope,
+	}
+}
+
+// PaymentRequiredError is raised when the account's plan or balance does not
+// cover the request.
+type PaymentRequiredError struct {
+	ActorHubError
+	UpgradeURL string // page where the plan can be upgraded, if reported
+}
+
+// NewPaymentRequiredError creates a new PaymentRequiredError.
+func NewPaymentRequiredError(message string, responseData map[string]interface{}, requestID string) *PaymentRequiredError {
+	if message == "" {
+		message = "Payment required"
+	}
+	upgradeURL, _ := responseData["upgrade_url"].(string)
+	return &PaymentRequiredError{
+		ActorHubError: ActorHubError{
+			Message:      message,
+			StatusCode:   402,
+			ResponseData: responseData,
+			RequestID:    requestID,
+		},
+		UpgradeURL: upgradeURL,
+	}
+}
+
+// RequestTooLargeError is raised when the request body exceeds the API's size
+// limit, usually because of embedded media.
+type RequestTooLargeError struct {
+	ActorHubError
+	MaxBytes int64 // size limit, if reported
+}
+
+// NewRequestTooLargeError creates a new RequestTooLargeError.
+func NewRequestTooLargeError(message string, responseData map[string]interface{}, requestID string) *RequestTooLargeError {
+	if message == "" {
+		message = "Request body too large"
+	}
+	maxBytes, _ := responseData["max_bytes"].(float64)
+	return &RequestTooLargeError{
+		ActorHubError: ActorHubError{
+			Message:      message,
+			StatusCode:   413,
+			ResponseData: responseData,
+			RequestID:    requestID,
+		},
+		MaxBytes: int64(maxBytes),
+	}
+}
+
 // RateLimitError is raised when rate limit is exceeded.
 type RateLimitError struct {
 	ActorHubError
-	RetryAfter int
+	RetryAfter int // seconds; zero if the server did not say
+}
+
+// RetryAfterDuration returns how long the server asked to wait before
+// retrying, or zero if it did not say.
+func (e *RateLimitError) RetryAfterDuration() time.Duration {
+	return time.Duration(e.RetryAfter) * time.Second
 }
 
 // NewRateLimitError creates a new RateLimitError.
@@ -103,6 +193,84 @@ func NewNotFoundError(message string, requestID string) *NotFoundError {
 	}
 }
 
+// ConflictError is raised when a request conflicts with the current state of a
+// resource, such as deleting an identity that still has active licenses.
+type ConflictError struct {
+	ActorHubError
+	ActiveLicenseIDs []string // licenses blocking the request, if any
+
+	// ResourceType and ResourceID identify the conflicting resource, such as
+	// an existing listing, if reported.
+	ResourceType string
+	ResourceID   string
+}
+
+// NewConflictError creates a new ConflictError.
+func NewConflictError(message string, responseData map[string]interface{}, requestID string) *ConflictError {
+	if message == "" {
+		message = "Request conflicts with the current state of the resource"
+	}
+	var licenseIDs []string
+	if ids, ok := responseData["active_license_ids"].([]interface{}); ok {
+		for _, id := range ids {
+			if s, ok := id.(string); ok {
+				licenseIDs = append(licenseIDs, s)
+			}
+		}
+	}
+	resource, _ := responseData["conflicting_resource"].(map[string]interface{})
+	resourceType, _ := resource["type"].(string)
+	resourceID, _ := resource["id"].(string)
+	return &ConflictError{
+		ActorHubError: ActorHubError{
+			Message:      message,
+			StatusCode:   409,
+			ResponseData: responseData,
+			RequestID:    requestID,
+		},
+		ActiveLicenseIDs: licenseIDs,
+		ResourceType:     resourceType,
+		ResourceID:       resourceID,
+	}
+}
+
+// BiometricConsentRequiredError is raised before a biometric request is sent
+// when the client requires consent evidence and none was provided.
+type BiometricConsentRequiredError struct {
+	ActorHubError
+}
+
+// NewBiometricConsentRequiredError creates a new BiometricConsentRequiredError.
+func NewBiometricConsentRequiredError(message string) *BiometricConsentRequiredError {
+	if message == "" {
+		message = "Biometric consent evidence is required: set consent_evidence_id"
+	}
+	return &BiometricConsentRequiredError{
+		ActorHubError: ActorHubError{
+			Message: message,
+		},
+	}
+}
+
+// MinorDetectedError is raised in strict child-safety mode when a face may
+// belong to a minor.
+type MinorDetectedError struct {
+	ActorHubError
+}
+
+// NewMinorDetectedError creates a new MinorDetectedError.
+func NewMinorDetectedError(message string, requestID string) *MinorDetectedError {
+	if message == "" {
+		message = "Potential minor detected"
+	}
+	return &MinorDetectedError{
+		ActorHubError: ActorHubError{
+			Message:   message,
+			RequestID: requestID,
+		},
+	}
+}
+
 // ServerError is raised when server returns 5xx error.
 type ServerError struct {
 	ActorHubError
@@ -121,3 +289,32 @@ func NewServerError(message string, statusCode int, requestID string) *ServerErr
 		},
 	}
 }
+
+// JobFailedError is returned when an asynchronous job fails or is canceled.
+type JobFailedError struct {
+	ActorHubError
+	JobID  string
+	Status JobStatus
+	Code   string
+}
+
+// NewJobFailedError creates a new JobFailedError from a job in a failed or
+// canceled state.
+func NewJobFailedError(job *Job) *JobFailedError {
+	message := fmt.Sprintf("Job %s %s", job.ID, job.Status)
+	code := ""
+	if job.Error != nil {
+		code = job.Error.Code
+		if job.Error.Message != "" {
+			message += ": " + job.Error.Message
+		}
+	}
+	return &JobFailedError{
+		ActorHubError: ActorHubError{
+			Message: message,
+		},
+		JobID:  job.ID,
+		Status: job.Status,
+		Code:   code,
+	}
+}
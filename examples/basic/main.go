@@ -33,11 +33,9 @@ func main() {
 		fmt.Printf("Protected: %v\n", verifyResult.Protected)
 		fmt.Printf("Faces detected: %d\n", verifyResult.FacesDetected)
 		for _, identity := range verifyResult.Identities {
-			if identity.DisplayName != nil {
-				fmt.Printf("  - Identity: %s (similarity: %.2f%%)\n",
-					*identity.DisplayName,
-					*identity.SimilarityScore*100)
-			}
+			fmt.Printf("  - Identity: %s (similarity: %.2f%%)\n",
+				identity.GetDisplayName(),
+				identity.GetSimilarityScore()*100)
 		}
 	}
 
@@ -45,8 +43,8 @@ func main() {
 	fmt.Println("\n=== Checking Consent ===")
 	consentResult, err := client.CheckConsent(ctx, &actorhub.ConsentCheckRequest{
 		ImageURL:    "https://example.com/face.jpg",
-		Platform:    "runway",
-		IntendedUse: "video",
+		Platform:    actorhub.PlatformRunway,
+		IntendedUse: actorhub.IntendedUseVideo,
 		Region:      "US",
 	})
 	if err != nil {
@@ -72,7 +70,7 @@ func main() {
 	} else {
 		fmt.Printf("Found %d listings:\n", len(listings))
 		for _, listing := range listings {
-			fmt.Printf("  - %s: $%.2f (%s)\n",
+			fmt.Printf("  - %s: $%s (%s)\n",
 				listing.Title,
 				listing.BasePriceUSD,
 				listing.Category)
@@ -89,7 +87,7 @@ func main() {
 		case *actorhub.AuthenticationError:
 			fmt.Printf("Auth error: %s\n", e.Message)
 		case *actorhub.RateLimitError:
-			fmt.Printf("Rate limited, retry after %d seconds\n", e.RetryAfter)
+			fmt.Printf("Rate limited, retry after %v\n", e.RetryAfterDuration())
 		default:
 			fmt.Printf("Other error: %v\n", err)
 		}
@@ -0,0 +1,64 @@
+package actorhub
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+)
+
+// FailurePolicy determines whether generations may proceed when consent
+// cannot be checked because the API is unavailable.
+type FailurePolicy int
+
+const (
+	// FailClosed denies generations while the API is unavailable. It is the
+	// default.
+	FailClosed FailurePolicy = iota
+
+	// FailOpen allows generations while the API is unavailable.
+	FailOpen
+)
+
+// String returns "fail_closed" or "fail_open".
+func (p FailurePolicy) String() string {
+	if p == FailOpen {
+		return "fail_open"
+	}
+	return "fail_closed"
+}
+
+// WithFailurePolicy sets what consent check wrappers, such as the pipeline
+// and firewall packages, decide when the API is unreachable after retries.
+// Their decisions are marked as degraded either way. Which policy is right
+// depends on your legal requirements; the default is FailClosed.
+func WithFailurePolicy(p FailurePolicy) ClientOption {
+	return func(c *Client) {
+		c.failurePolicy = p
+	}
+}
+
+// FailurePolicy returns the client's failure policy.
+func (c *Client) FailurePolicy() FailurePolicy {
+	return c.failurePolicy
+}
+
+// IsUnavailable reports whether err means the API could not be reached or
+// could not serve a call after retries: a network failure or timeout, a
+// server error, or a rate limit. Cancellation by the caller does not count,
+// nor does a response that arrived but could not be trusted or decoded, such
+// as one failing signature verification.
+func IsUnavailable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ErrUploadTimeout) || errors.Is(err, ErrProcessingTimeout) {
+		return true
+	}
+	var serverErr *ServerError
+	var rateLimitErr *RateLimitError
+	var urlErr *url.Error
+	var netErr net.Error
+	return errors.As(err, &serverErr) || errors.As(err, &rateLimitErr) ||
+		errors.As(err, &urlErr) || errors.As(err, &netErr)
+}
@@ -0,0 +1,52 @@
+package actorhub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIsUnavailable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"server error", NewServerError("", 503, ""), true},
+		{"rate limit", NewRateLimitError("", 1, ""), true},
+		{"deadline", fmt.Errorf("failed to send request: %w", context.DeadlineExceeded), true},
+		{"processing timeout", ErrProcessingTimeout, true},
+		{"canceled", fmt.Errorf("failed to send request: %w", context.Canceled), false},
+		{"invalid signature", &RequestError{Err: ErrInvalidResponseSignature}, false},
+		{"validation", NewValidationError("bad", nil, ""), false},
+	}
+	for _, tt := range tests {
+		if got := IsUnavailable(tt.err); got != tt.want {
+			t.Errorf("%s: IsUnavailable = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestIsUnavailableFromClient(t *testing.T) {
+	garbled := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"protected": "not a bool"`))
+	}))
+	defer garbled.Close()
+
+	c := NewClient("key", WithBaseURL(garbled.URL), WithMaxRetries(1))
+	_, err := c.Verify(context.Background(), &VerifyRequest{ImageURL: "https://example.com/a.jpg"})
+	if err == nil || IsUnavailable(err) {
+		t.Errorf("garbled response: err = %v, IsUnavailable = %v, want an error that is not unavailability", err, IsUnavailable(err))
+	}
+
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	down.Close()
+	c = NewClient("key", WithBaseURL(down.URL), WithMaxRetries(1))
+	_, err = c.Verify(context.Background(), &VerifyRequest{ImageURL: "https://example.com/a.jpg"})
+	if !IsUnavailable(err) {
+		t.Errorf("unreachable server: IsUnavailable(%v) = false, want true", err)
+	}
+}
@@ -0,0 +1,37 @@
+package actorhub
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type fieldsKey struct{}
+
+// WithFields returns a context that asks read endpoints, such as GetIdentity
+// and GetMyLicenses, to return only the named JSON fields of each object,
+// cutting payload size when only a few are needed:
+//
+//	ctx := actorhub.WithFields(ctx, "id", "display_name")
+//	identity, err := client.GetIdentity(ctx, id)
+//
+// Responses decode into the usual types; omitted pointer fields are nil and
+// others hold their zero value. Write calls ignore the fields. For listings,
+// set MarketplaceListRequest.Fields instead.
+func WithFields(ctx context.Context, fields ...string) context.Context {
+	return context.WithValue(ctx, fieldsKey{}, fields)
+}
+
+// withFields adds the fields set on ctx to a GET path.
+func withFields(ctx context.Context, method, path string) string {
+	fields, _ := ctx.Value(fieldsKey{}).([]string)
+	if len(fields) == 0 || method != http.MethodGet || strings.Contains(path, "fields=") {
+		return path
+	}
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	return path + sep + url.Values{"fields": {strings.Join(fields, ",")}}.Encode()
+}
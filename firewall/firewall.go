@@ -0,0 +1,467 @@
+// Package firewall enforces ActorHub consent checks on requests sent to
+// upstream generative AI APIs.
+//
+// Transport is an http.RoundTripper that recognizes requests to OpenAI,
+// Stability AI, and Runway, extracts their image inputs and prompts, runs
+// Client.CheckConsent on every image, and evaluates the results with the
+// policy package. Wrap the transport of an existing HTTP client to enforce
+// consent without changing any call sites:
+//
+//	httpClient := &http.Client{
+//	    Transport: firewall.New(client, http.DefaultTransport,
+//	        firewall.WithParams(policy.Params{Commercial: true, Region: "US"}),
+//	    ),
+//	}
+//
+// Denied requests fail with a *BlockedError before reaching the upstream API.
+// In annotate mode they are forwarded instead, and the decision is reported
+// in the X-ActorHub-Decision response header. The firewall fails closed: an
+// intercepted request whose body it cannot read, such as one in an
+// unsupported content type or encoding, is denied rather than forwarded
+// unchecked.
+package firewall
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	actorhub "github.com/actorhubai/actorhub-go"
+	"github.com/actorhubai/actorhub-go/policy"
+)
+
+// Response headers set on forwarded requests.
+const (
+	HeaderDecision = "X-ActorHub-Decision" // "allowed" or "denied"
+	HeaderReasons  = "X-ActorHub-Reasons"  // comma-separated policy reason codes
+	HeaderDegraded = "X-ActorHub-Degraded" // "true" if the failure policy decided
+)
+
+// DefaultHosts maps the upstream API hosts intercepted by default to the
+// platform name used in consent checks.
+var DefaultHosts = map[string]string{
+	"api.openai.com":       "openai",
+	"api.stability.ai":     "stability",
+	"api.us.stability.ai":  "stability",
+	"api.dev.runwayml.com": "runway",
+	"api.runwayml.com":     "runway",
+}
+
+// Field names that carry image inputs or prompts in upstream requests.
+var (
+	imageFields  = []string{"image", "image[]", "images", "init_image", "image_url", "input_image", "promptImage", "reference_images"}
+	promptFields = []string{"prompt", "promptText", "negative_prompt", "text", "input", "content"}
+)
+
+// ErrUnsupportedBody is returned by Extract for a request body in a content
+// type it cannot read.
+var ErrUnsupportedBody = errors.New("actorhub firewall: unsupported request body")
+
+// Inputs holds the image inputs and prompts extracted from an upstream request.
+type Inputs struct {
+	Platform string
+	Images   []actorhub.ImageInput
+	Prompts  []string
+}
+
+// PromptCheck screens the prompts of an upstream request.
+type PromptCheck func(ctx context.Context, inputs *Inputs) (policy.Decision, error)
+
+// BlockedError is returned by Transport.RoundTrip when a request is denied.
+type BlockedError struct {
+	Platform string
+	Decision policy.Decision
+}
+
+func (e *BlockedError) Error() string {
+	msg := "actorhub firewall: request to " + e.Platform + " blocked"
+	if len(e.Decision.Reasons) > 0 {
+		msg += ": " + e.Decision.Reasons[0].Message
+	}
+	return msg
+}
+
+// Transport is an http.RoundTripper that runs consent checks on requests to
+// upstream generative AI APIs.
+type Transport struct {
+	client      *actorhub.Client
+	base        http.RoundTripper
+	hosts       map[string]string
+	params      policy.Params
+	promptCheck PromptCheck
+	annotate    bool
+}
+
+// Option configures a Transport.
+type Option func(*Transport)
+
+// WithParams sets the policy parameters for evaluating consent. Platform is
+// filled in from the request host when empty.
+func WithParams(p policy.Params) Option {
+	return func(t *Transport) {
+		t.params = p
+	}
+}
+
+// WithHost intercepts requests to an additional host, such as a self-hosted
+// or Azure OpenAI endpoint, checking consent for the given platform.
+func WithHost(host, platform string) Option {
+	return func(t *Transport) {
+		t.hosts[strings.ToLower(host)] = platform
+	}
+}
+
+// WithPromptCheck screens the prompts of each intercepted request. A denial
+// is combined with the consent decision for the images.
+func WithPromptCheck(check PromptCheck) Option {
+	return func(t *Transport) {
+		t.promptCheck = check
+	}
+}
+
+// WithAnnotateOnly forwards denied requests instead of blocking them, setting
+// the X-ActorHub-Decision and X-ActorHub-Reasons response headers.
+func WithAnnotateOnly() Option {
+	return func(t *Transport) {
+		t.annotate = true
+	}
+}
+
+// New returns a Transport that checks consent with client before passing
+// requests to base. If base is nil, http.DefaultTransport is used.
+func New(client *actorhub.Client, base http.RoundTripper, opts ...Option) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	t := &Transport{
+		client: client,
+		base:   base,
+		hosts:  make(map[string]string, len(DefaultHosts)),
+	}
+	for host, platform := range DefaultHosts {
+		t.hosts[host] = platform
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	platform, ok := t.hosts[strings.ToLower(req.URL.Hostname())]
+	if !ok || req.Body == nil || req.Method != http.MethodPost {
+		return t.base.RoundTrip(req)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("actorhub firewall: failed to read request body: %w", err)
+	}
+
+	var decision policy.Decision
+	inputs, err := extractRequest(req.Header, body)
+	if err != nil {
+		// A body that cannot be checked is denied, not forwarded unchecked.
+		decision = policy.Decision{Reasons: []policy.Reason{{
+			Code:    policy.ReasonUnreadableRequest,
+			Message: err.Error(),
+		}}}
+	} else {
+		inputs.Platform = platform
+		decision, err = t.evaluate(req.Context(), inputs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !decision.Allowed && !t.annotate {
+		return nil, &BlockedError{Platform: platform, Decision: decision}
+	}
+
+	// RoundTrippers must not modify the caller's request.
+	out := req.Clone(req.Context())
+	out.Body = io.NopCloser(bytes.NewReader(body))
+	out.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	out.ContentLength = int64(len(body))
+
+	resp, err := t.base.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	annotate(resp.Header, decision)
+	return resp, nil
+}
+
+// evaluate checks consent for every image input and screens the prompts.
+func (t *Transport) evaluate(ctx context.Context, inputs *Inputs) (policy.Decision, error) {
+	params := t.params
+	if params.Platform == "" {
+		params.Platform = inputs.Platform
+	}
+	intendedUse := actorhub.IntendedUseImage
+	if params.Video {
+		intendedUse = actorhub.IntendedUseVideo
+	}
+
+	decision := policy.Decision{Allowed: true}
+	merge := func(d policy.Decision) {
+		if d.Degraded {
+			decision.Degraded = true
+			if d.Allowed {
+				decision.Reasons = append(decision.Reasons, d.Reasons...)
+			}
+		}
+		if !d.Allowed {
+			decision.Allowed = false
+			decision.Reasons = append(decision.Reasons, d.Reasons...)
+		}
+	}
+
+	for _, image := range inputs.Images {
+		resp, err := t.client.CheckConsent(ctx, &actorhub.ConsentCheckRequest{
+			ImageURL:    image.URL,
+			ImageBase64: image.Base64,
+			Platform:    actorhub.Platform(params.Platform),
+			IntendedUse: intendedUse,
+			Region:      params.Region,
+		})
+		var minorErr *actorhub.MinorDetectedError
+		if errors.As(err, &minorErr) {
+			merge(policy.Decision{Reasons: []policy.Reason{{
+				Code:    policy.ReasonPotentialMinor,
+				Message: "face may belong to a minor",
+			}}})
+			continue
+		}
+		if actorhub.IsUnavailable(err) {
+			merge(policy.DegradedDecision(t.client.FailurePolicy(), err))
+			continue
+		}
+		if err != nil {
+			return policy.Decision{}, err
+		}
+		d := policy.Evaluate(resp, params)
+		d.Degraded = resp.Stale
+		merge(d)
+	}
+
+	if t.promptCheck != nil && len(inputs.Prompts) > 0 {
+		d, err := t.promptCheck(ctx, inputs)
+		if err != nil {
+			return policy.Decision{}, err
+		}
+		merge(d)
+	}
+
+	return decision, nil
+}
+
+// annotate records the decision in response headers.
+func annotate(h http.Header, decision policy.Decision) {
+	if decision.Degraded {
+		h.Set(HeaderDegraded, "true")
+	}
+	if decision.Allowed {
+		h.Set(HeaderDecision, "allowed")
+		return
+	}
+	h.Set(HeaderDecision, "denied")
+	codes := make([]string, 0, len(decision.Reasons))
+	for _, r := range decision.Reasons {
+		codes = append(codes, string(r.Code))
+	}
+	h.Set(HeaderReasons, strings.Join(codes, ","))
+}
+
+// extractRequest decodes the Content-Encoding of an intercepted request
+// body and extracts its inputs.
+func extractRequest(header http.Header, body []byte) (*Inputs, error) {
+	switch encoding := strings.ToLower(strings.TrimSpace(header.Get("Content-Encoding"))); encoding {
+	case "", "identity":
+	case "gzip", "x-gzip":
+		zr, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("actorhub firewall: failed to decompress body: %w", err)
+		}
+		if body, err = io.ReadAll(zr); err != nil {
+			return nil, fmt.Errorf("actorhub firewall: failed to decompress body: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("%w: Content-Encoding %q", ErrUnsupportedBody, encoding)
+	}
+	return Extract(header.Get("Content-Type"), body)
+}
+
+// Extract returns the image inputs and prompts in a JSON, multipart, or
+// URL-encoded form request body. Images may be URLs, data URIs, or uploaded
+// files. A body in any other content type fails with ErrUnsupportedBody; a
+// body without a content type must be JSON.
+func Extract(contentType string, body []byte) (*Inputs, error) {
+	inputs := &Inputs{}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return inputs, nil
+	}
+	mediaType, params, _ := mime.ParseMediaType(contentType)
+
+	switch {
+	case mediaType == "multipart/form-data":
+		if err := extractMultipart(params["boundary"], body, inputs); err != nil {
+			return nil, fmt.Errorf("actorhub firewall: failed to parse multipart body: %w", err)
+		}
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return nil, fmt.Errorf("actorhub firewall: failed to parse form body: %w", err)
+		}
+		extractForm(values, inputs)
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json") || mediaType == "":
+		var v interface{}
+		if err := json.Unmarshal(body, &v); err != nil {
+			return nil, fmt.Errorf("actorhub firewall: failed to parse JSON body: %w", err)
+		}
+		extractJSON(v, inputs)
+	default:
+		return nil, fmt.Errorf("%w: Content-Type %q", ErrUnsupportedBody, mediaType)
+	}
+
+	return inputs, nil
+}
+
+func extractForm(values url.Values, inputs *Inputs) {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range values[name] {
+			switch {
+			case contains(imageFields, name):
+				addImage(value, inputs)
+			case contains(promptFields, name):
+				addPrompt(value, inputs)
+			}
+		}
+	}
+}
+
+func extractMultipart(boundary string, body []byte, inputs *Inputs) error {
+	mr := multipart.NewReader(bytes.NewReader(body), boundary)
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(part)
+		if err != nil {
+			return err
+		}
+
+		name := part.FormName()
+		switch {
+		case contains(imageFields, name) && part.FileName() != "":
+			inputs.Images = append(inputs.Images, actorhub.ImageInput{
+				Base64: base64.StdEncoding.EncodeToString(data),
+			})
+		case contains(imageFields, name):
+			addImage(string(data), inputs)
+		case contains(promptFields, name):
+			addPrompt(string(data), inputs)
+		}
+	}
+}
+
+func extractJSON(v interface{}, inputs *Inputs) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			val := v[key]
+			switch {
+			case contains(imageFields, key):
+				collectImages(val, inputs)
+			case contains(promptFields, key):
+				if s, ok := val.(string); ok {
+					addPrompt(s, inputs)
+					continue
+				}
+				extractJSON(val, inputs)
+			default:
+				extractJSON(val, inputs)
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			extractJSON(item, inputs)
+		}
+	}
+}
+
+// collectImages handles the image value shapes used by upstream APIs: a URL
+// or data URI string, an object with "url" or "uri", or an array of either.
+func collectImages(v interface{}, inputs *Inputs) {
+	switch v := v.(type) {
+	case string:
+		addImage(v, inputs)
+	case map[string]interface{}:
+		for _, key := range []string{"url", "uri", "image_url"} {
+			if val, ok := v[key]; ok {
+				collectImages(val, inputs)
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			collectImages(item, inputs)
+		}
+	}
+}
+
+func addImage(s string, inputs *Inputs) {
+	s = strings.TrimSpace(s)
+	switch {
+	case strings.HasPrefix(s, "http://"), strings.HasPrefix(s, "https://"):
+		inputs.Images = append(inputs.Images, actorhub.ImageInput{URL: s})
+	case strings.HasPrefix(s, "data:"):
+		if i := strings.Index(s, ";base64,"); i >= 0 {
+			inputs.Images = append(inputs.Images, actorhub.ImageInput{Base64: s[i+len(";base64,"):]})
+		}
+	case s != "":
+		inputs.Images = append(inputs.Images, actorhub.ImageInput{Base64: s})
+	}
+}
+
+func addPrompt(s string, inputs *Inputs) {
+	if s = strings.TrimSpace(s); s != "" {
+		inputs.Prompts = append(inputs.Prompts, s)
+	}
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
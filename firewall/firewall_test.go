@@ -0,0 +1,149 @@
+package firewall
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	actorhub "github.com/actorhubai/actorhub-go"
+	"github.com/actorhubai/actorhub-go/policy"
+)
+
+// roundTripFunc stands in for the upstream API.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// newTestTransport returns a transport whose consent checks deny every face
+// for commercial use, counting the checks and forwarded requests.
+func newTestTransport(t *testing.T, checks, forwarded *atomic.Int32, opts ...Option) *Transport {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checks.Add(1)
+		w.Write([]byte(`{"request_id":"req_1","protected":true,"faces_detected":1,
+			"faces":[{"protected":true,"identity_id":"id_1","consent":{"commercial_use":false}}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	client := actorhub.NewClient("key", actorhub.WithBaseURL(srv.URL), actorhub.WithMaxRetries(1))
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		forwarded.Add(1)
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	})
+	opts = append([]Option{WithHost("upstream.test", "runway"), WithParams(policy.Params{Commercial: true})}, opts...)
+	return New(client, base, opts...)
+}
+
+func newUpstreamRequest(t *testing.T, contentType, contentEncoding string, body []byte) *http.Request {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, "https://upstream.test/v1/generate", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if contentEncoding != "" {
+		req.Header.Set("Content-Encoding", contentEncoding)
+	}
+	return req
+}
+
+func gzipped(t *testing.T, s string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write([]byte(s))
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestTransportChecksImages(t *testing.T) {
+	imageJSON := `{"prompt":"a portrait","image_url":"https://example.com/a.jpg"}`
+	tests := []struct {
+		name            string
+		contentType     string
+		contentEncoding string
+		body            []byte
+	}{
+		{"json", "application/json", "", []byte(imageJSON)},
+		{"gzip json", "application/json", "gzip", gzipped(t, imageJSON)},
+		{"form", "application/x-www-form-urlencoded", "", []byte(url.Values{"image_url": {"https://example.com/a.jpg"}}.Encode())},
+	}
+	for _, tt := range tests {
+		var checks, forwarded atomic.Int32
+		tr := newTestTransport(t, &checks, &forwarded)
+		_, err := tr.RoundTrip(newUpstreamRequest(t, tt.contentType, tt.contentEncoding, tt.body))
+		var blocked *BlockedError
+		if !errors.As(err, &blocked) || blocked.Decision.Reasons[0].Code != policy.ReasonCommercialNotAllowed {
+			t.Errorf("%s: err = %v, want a commercial-use denial", tt.name, err)
+		}
+		if checks.Load() != 1 || forwarded.Load() != 0 {
+			t.Errorf("%s: %d consent checks, %d forwarded; want 1 and 0", tt.name, checks.Load(), forwarded.Load())
+		}
+	}
+}
+
+func TestTransportFailsClosedOnUnreadableBodies(t *testing.T) {
+	tests := []struct {
+		name            string
+		contentType     string
+		contentEncoding string
+		body            string
+	}{
+		{"unknown content type", "text/plain", "", "https://example.com/a.jpg"},
+		{"invalid JSON without content type", "", "", "image_url=https://example.com/a.jpg"},
+		{"unknown encoding", "application/json", "br", "\x1b\x00"},
+		{"corrupt gzip", "application/json", "gzip", "not gzip"},
+	}
+	for _, tt := range tests {
+		var checks, forwarded atomic.Int32
+		tr := newTestTransport(t, &checks, &forwarded)
+		_, err := tr.RoundTrip(newUpstreamRequest(t, tt.contentType, tt.contentEncoding, []byte(tt.body)))
+		var blocked *BlockedError
+		if !errors.As(err, &blocked) || blocked.Decision.Reasons[0].Code != policy.ReasonUnreadableRequest {
+			t.Errorf("%s: err = %v, want an unreadable-request denial", tt.name, err)
+		}
+		if forwarded.Load() != 0 {
+			t.Errorf("%s: request was forwarded unchecked", tt.name)
+		}
+	}
+}
+
+func TestTransportAnnotatesUnreadableBodies(t *testing.T) {
+	var checks, forwarded atomic.Int32
+	tr := newTestTransport(t, &checks, &forwarded, WithAnnotateOnly())
+	resp, err := tr.RoundTrip(newUpstreamRequest(t, "text/plain", "", []byte("hello")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Header.Get(HeaderDecision) != "denied" || resp.Header.Get(HeaderReasons) != string(policy.ReasonUnreadableRequest) {
+		t.Errorf("headers = %v, want a denied unreadable_request annotation", resp.Header)
+	}
+}
+
+func TestTransportPassesOtherRequests(t *testing.T) {
+	var checks, forwarded atomic.Int32
+	tr := newTestTransport(t, &checks, &forwarded)
+	req, _ := http.NewRequest(http.MethodPost, "https://elsewhere.test/v1", strings.NewReader("anything"))
+	if _, err := tr.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tr.RoundTrip(newUpstreamRequest(t, "application/json", "", nil)); err != nil {
+		t.Errorf("empty body: %v", err)
+	}
+	if checks.Load() != 0 || forwarded.Load() != 2 {
+		t.Errorf("%d consent checks, %d forwarded; want 0 and 2", checks.Load(), forwarded.Load())
+	}
+}
@@ -0,0 +1,142 @@
+package actorhub
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// hedgeablePaths lists POST endpoints that only read state, so duplicate
+// requests are safe to send.
+var hedgeablePaths = map[string]bool{
+	"/api/v1/identity/verify":        true,
+	"/api/v1/identity/verify/output": true,
+	"/api/v1/consent/check":          true,
+	"/api/v1/consent/screen-prompt":  true,
+	"/api/v1/voice/verify":           true,
+	"/api/v1/verify/multimodal":      true,
+}
+
+// WithHedging sends up to maxHedges duplicate requests, each after a further
+// delay without a response, and uses whichever response arrives first. Only
+// idempotent calls are hedged: GET requests and read-only checks such as
+// Verify and CheckConsent. Hedged requests count against your rate limit and
+// usage, so choose a delay near your p95 latency.
+func WithHedging(delay time.Duration, maxHedges int) ClientOption {
+	return func(c *Client) {
+		c.hedgeDelay = delay
+		c.maxHedges = maxHedges
+	}
+}
+
+// hedgeable reports whether a request may be hedged.
+func (c *Client) hedgeable(method, path string, result interface{}) bool {
+	if c.hedgeDelay <= 0 || c.maxHedges <= 0 {
+		return false
+	}
+	if _, ok := result.(io.Writer); ok {
+		return false // streamed bodies cannot be duplicated
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return true
+	case http.MethodPost:
+		if i := strings.IndexByte(path, '?'); i >= 0 {
+			path = path[:i]
+		}
+		return hedgeablePaths[path]
+	}
+	return false
+}
+
+// doAttempt performs one attempt of a request, hedging it if allowed.
+func (c *Client) doAttempt(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	if !c.hedgeable(method, path, result) {
+		return c.doRequestOnce(ctx, method, path, body, result)
+	}
+	return c.doRequestHedged(ctx, method, path, body, result)
+}
+
+// doRequestHedged races the original request against delayed duplicates.
+// Each request decodes into its own value so that only the winner's response
+// is copied into result.
+func (c *Client) doRequestHedged(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	type outcome struct {
+		index int
+		value reflect.Value
+		err   error
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	outcomes := make(chan outcome, c.maxHedges+1)
+	metadata := make([]ResponseMetadata, c.maxHedges+1)
+	responses := make([]APIResponse, c.maxHedges+1)
+	launch := func(i int) {
+		go func() {
+			var target interface{}
+			var value reflect.Value
+			if result != nil {
+				value = reflect.New(reflect.TypeOf(result).Elem())
+				target = value.Interface()
+			}
+			attemptCtx := WithAPIResponse(WithResponseMetadata(ctx, &metadata[i]), &responses[i])
+			err := c.doRequestOnce(attemptCtx, method, path, body, target)
+			outcomes <- outcome{index: i, value: value, err: err}
+		}()
+	}
+
+	launch(0)
+	launched, pending := 1, 1
+	timer := time.NewTimer(c.hedgeDelay)
+	defer timer.Stop()
+
+	var lastErr error
+	for {
+		select {
+		case <-timer.C:
+			if launched <= c.maxHedges {
+				launch(launched)
+				launched++
+				pending++
+				timer.Reset(c.hedgeDelay)
+			}
+		case o := <-outcomes:
+			pending--
+			if o.err == nil || hedgeFinal(o.err) {
+				if o.err == nil && result != nil {
+					reflect.ValueOf(result).Elem().Set(o.value.Elem())
+				}
+				if dst, ok := ctx.Value(responseMetadataKey{}).(*ResponseMetadata); ok && dst != nil {
+					*dst = metadata[o.index]
+				}
+				if dst, ok := ctx.Value(apiResponseKey{}).(*APIResponse); ok && dst != nil {
+					*dst = responses[o.index]
+				}
+				return o.err
+			}
+			lastErr = o.err
+			// Leave retrying a request that failed outright to doRequest.
+			if pending == 0 {
+				return lastErr
+			}
+		}
+	}
+}
+
+// hedgeFinal reports whether an error would be the same for every duplicate
+// of a request, so there is no point waiting for the others.
+func hedgeFinal(err error) bool {
+	switch err.(type) {
+	case *RateLimitError, *ServerError:
+		return false
+	case *AuthenticationError, *ForbiddenError, *PaymentRequiredError, *NotFoundError,
+		*ConflictError, *RequestTooLargeError, *ValidationError, *ActorHubError:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package actorhub
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// defaultHTTPCacheSize is the number of responses kept by NewMemoryHTTPCache
+// when no size is given.
+const defaultHTTPCacheSize = 1000
+
+// CachedResponse is a response body stored by an HTTPCache with the ETag
+// that validates it.
+type CachedResponse struct {
+	ETag string
+	Body []byte
+}
+
+// HTTPCache stores GET responses for conditional requests. Implementations
+// must be safe for concurrent use; keys already distinguish API keys, so a
+// store may be shared between clients.
+type HTTPCache interface {
+	Get(key string) (CachedResponse, bool)
+	Set(key string, resp CachedResponse)
+}
+
+// WithHTTPCache stores GET responses that carry an ETag in store and
+// revalidates them with If-None-Match, so a 304 Not Modified answer is
+// served from the cache without downloading the body again. Identity and
+// listing data, which rarely change, benefit most. Downloads streamed to a
+// writer are not cached, nor is anything when WithResponseVerification is
+// enabled, since signatures cover the full response.
+func WithHTTPCache(store HTTPCache) ClientOption {
+	return func(c *Client) {
+		c.httpCache = store
+	}
+}
+
+// NewMemoryHTTPCache returns an in-memory HTTPCache holding up to
+// maxEntries responses, 1000 if maxEntries <= 0, evicting the least
+// recently stored first.
+func NewMemoryHTTPCache(maxEntries int) HTTPCache {
+	if maxEntries <= 0 {
+		maxEntries = defaultHTTPCacheSize
+	}
+	return &memoryHTTPCache{max: maxEntries, entries: make(map[string]memoryHTTPCacheEntry)}
+}
+
+type memoryHTTPCache struct {
+	max int
+
+	mu      sync.Mutex
+	entries map[string]memoryHTTPCacheEntry
+}
+
+type memoryHTTPCacheEntry struct {
+	resp CachedResponse
+	at   time.Time
+}
+
+func (m *memoryHTTPCache) Get(key string) (CachedResponse, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.entries[key]
+	return entry.resp, ok
+}
+
+func (m *memoryHTTPCache) Set(key string, resp CachedResponse) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.entries[key]; !ok && len(m.entries) >= m.max {
+		var oldest string
+		var oldestAt time.Time
+		for k, e := range m.entries {
+			if oldest == "" || e.at.Before(oldestAt) {
+				oldest, oldestAt = k, e.at
+			}
+		}
+		delete(m.entries, oldest)
+	}
+	m.entries[key] = memoryHTTPCacheEntry{resp: resp, at: time.Now()}
+}
+
+// httpCacheKey identifies a GET request by API key and path, including the
+// query.
+func (c *Client) httpCacheKey(path string) string {
+	sum := sha256.Sum256([]byte(c.apiKey + "\x00" + path))
+	return hex.EncodeToString(sum[:])
+}
+
+// cacheable reports whether a request may use the HTTP cache.
+func (c *Client) cacheable(method string, result interface{}) bool {
+	if c.httpCache == nil || c.verifyResponses || method != http.MethodGet {
+		return false
+	}
+	_, stream := result.(io.Writer)
+	return !stream
+}
+
+// cachedExchange follows one cacheable request through its response.
+type cachedExchange struct {
+	key    string
+	cached CachedResponse
+	hit    bool
+	body   bytes.Buffer
+}
+
+// beginCache looks up a cacheable request and makes it conditional,
+// returning nil if the request is not cacheable.
+func (c *Client) beginCache(req *http.Request, path string, result interface{}) *cachedExchange {
+	if !c.cacheable(req.Method, result) {
+		return nil
+	}
+	x := &cachedExchange{key: c.httpCacheKey(path)}
+	x.cached, x.hit = c.httpCache.Get(x.key)
+	if x.hit && x.cached.ETag != "" {
+		req.Header.Set("If-None-Match", x.cached.ETag)
+	}
+	return x
+}
+
+// substitute replaces the body of a 304 response with the cached one, or
+// records the body of a fresh response as it is read.
+func (x *cachedExchange) substitute(resp *http.Response) {
+	if resp.StatusCode == http.StatusNotModified && x.hit {
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(x.cached.Body))
+		return
+	}
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.TeeReader(resp.Body, &x.body), resp.Body}
+}
+
+// finishCache stores a fresh successful response that has an ETag.
+func (c *Client) finishCache(x *cachedExchange, resp *http.Response, err error) {
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return
+	}
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		c.httpCache.Set(x.key, CachedResponse{ETag: etag, Body: x.body.Bytes()})
+	}
+}
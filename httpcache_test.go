@@ -0,0 +1,90 @@
+package actorhub
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// etagServer serves a signed identity with an ETag, answering 304 Not
+// Modified to a matching If-None-Match and counting conditional requests.
+func etagServer(t *testing.T, conditional *atomic.Int32) *httptest.Server {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := `{"id":"id_1","display_name":"Ada"}`
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == jwksPath {
+			fmt.Fprintf(w, `{"keys":[{"kty":"EC","crv":"P-256","kid":"k1","x":"%s","y":"%s"}]}`,
+				base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+				base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))))
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			conditional.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		digest := sha256.Sum256([]byte(body))
+		sr, ss, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		sig := append(sr.FillBytes(make([]byte, 32)), ss.FillBytes(make([]byte, 32))...)
+		w.Header().Set("X-Signature", base64.RawURLEncoding.EncodeToString(sig))
+		w.Header().Set("X-Signature-Key-ID", "k1")
+		w.Write([]byte(body))
+	}))
+}
+
+func TestHTTPCacheServesNotModified(t *testing.T) {
+	var conditional atomic.Int32
+	srv := etagServer(t, &conditional)
+	defer srv.Close()
+	c := NewClient("key", WithBaseURL(srv.URL), WithMaxRetries(1), WithHTTPCache(NewMemoryHTTPCache(0)))
+
+	for i := 0; i < 2; i++ {
+		identity, err := c.GetIdentity(context.Background(), "id_1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if identity.DisplayName != "Ada" {
+			t.Errorf("request %d: DisplayName = %q, want Ada", i, identity.DisplayName)
+		}
+	}
+	if n := conditional.Load(); n != 1 {
+		t.Errorf("conditional requests = %d, want 1", n)
+	}
+}
+
+func TestHTTPCacheBypassedWithResponseVerification(t *testing.T) {
+	var conditional atomic.Int32
+	srv := etagServer(t, &conditional)
+	defer srv.Close()
+	c := NewClient("key", WithBaseURL(srv.URL), WithMaxRetries(1), WithHTTPCache(NewMemoryHTTPCache(0)), WithResponseVerification())
+
+	for i := 0; i < 2; i++ {
+		identity, err := c.GetIdentity(context.Background(), "id_1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if identity.DisplayName != "Ada" {
+			t.Errorf("request %d: DisplayName = %q, want Ada", i, identity.DisplayName)
+		}
+	}
+	if n := conditional.Load(); n != 0 {
+		t.Errorf("conditional requests = %d, want 0 with response verification", n)
+	}
+}
@@ -0,0 +1,154 @@
+package actorhub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	// jobPollInterval is the minimum delay between job status checks.
+	jobPollInterval = 2 * time.Second
+
+	// jobLongPollWait is how long the server may hold a job status request
+	// open waiting for the job to change.
+	jobLongPollWait = 30 * time.Second
+)
+
+// JobType represents the kind of work an asynchronous job performs.
+type JobType string
+
+const (
+	JobTypeVideoVerification JobType = "video_verification"
+	JobTypeBatch             JobType = "batch"
+	JobTypeTraining          JobType = "training"
+)
+
+// IsTerminal reports whether a job in this status will not change again.
+func (s JobStatus) IsTerminal() bool {
+	switch s {
+	case JobStatusSucceeded, JobStatusFailed, JobStatusCanceled:
+		return true
+	}
+	return false
+}
+
+// JobError describes why a job failed.
+type JobError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Job represents an asynchronous job of any type. Result holds the job's
+// output once it has succeeded; decode it with DecodeResult or use
+// WaitForJob.
+type Job struct {
+	ID          string          `json:"id"`
+	Type        JobType         `json:"type"`
+	Status      JobStatus       `json:"status"`
+	Progress    int             `json:"progress"` // percent complete
+	Result      json.RawMessage `json:"result,omitempty"`
+	Error       *JobError       `json:"error,omitempty"`
+	CreatedAt   *Time           `json:"created_at,omitempty"`
+	UpdatedAt   *Time           `json:"updated_at,omitempty"`
+	CompletedAt *Time           `json:"completed_at,omitempty"`
+}
+
+// DecodeResult decodes the result of a succeeded job into v.
+func (j *Job) DecodeResult(v interface{}) error {
+	if j.Status != JobStatusSucceeded {
+		return fmt.Errorf("job %s has no result in status %q", j.ID, j.Status)
+	}
+	if err := json.Unmarshal(j.Result, v); err != nil {
+		return fmt.Errorf("failed to decode job result: %w", err)
+	}
+	return nil
+}
+
+// JobsService provides access to asynchronous jobs such as video
+// verification, batch operations, and Actor Pack training.
+type JobsService struct {
+	client *Client
+}
+
+// Jobs returns the service for asynchronous jobs.
+func (c *Client) Jobs() *JobsService {
+	return &JobsService{client: c}
+}
+
+// GetJob retrieves the status of a job.
+func (s *JobsService) GetJob(ctx context.Context, jobID string) (*Job, error) {
+	return s.getJob(ctx, jobID, 0)
+}
+
+// getJob retrieves a job, asking the server to hold the request for up to
+// wait until the job reaches a terminal state.
+func (s *JobsService) getJob(ctx context.Context, jobID string, wait time.Duration) (*Job, error) {
+	path := "/api/v1/jobs/" + jobID
+	if wait > 0 {
+		path += "?" + url.Values{"wait": {wait.String()}}.Encode()
+	}
+
+	var result Job
+	err := s.client.doRequest(ctx, http.MethodGet, path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// longPollWait returns the server wait for long-polling, kept short enough to
+// finish within the client's request timeout.
+func (s *JobsService) longPollWait() time.Duration {
+	wait := jobLongPollWait
+	if timeout := s.client.responseTimeout(); timeout > 0 && wait > timeout-5*time.Second {
+		wait = timeout - 5*time.Second
+	}
+	return wait
+}
+
+// WaitForJob waits for a job to reach a terminal state and decodes its
+// result as T. Status requests are long-polled, so the result arrives as soon
+// as the job finishes; if the server answers immediately instead, WaitForJob
+// falls back to polling at a fixed interval. For example:
+//
+//	job, err := client.SubmitVerifyJob(ctx, req, "")
+//	result, err := actorhub.WaitForJob[actorhub.VideoVerifyResponse](ctx, client.Jobs(), job.ID)
+//
+// A JobFailedError is returned if the job fails or is canceled.
+func WaitForJob[T any](ctx context.Context, jobs *JobsService, jobID string) (*T, error) {
+	wait := jobs.longPollWait()
+	for {
+		start := time.Now()
+		job, err := jobs.getJob(ctx, jobID, wait)
+		if err != nil {
+			return nil, err
+		}
+
+		switch job.Status {
+		case JobStatusSucceeded:
+			var result T
+			if err := job.DecodeResult(&result); err != nil {
+				return nil, err
+			}
+			return &result, nil
+		case JobStatusFailed, JobStatusCanceled:
+			return nil, NewJobFailedError(job)
+		}
+
+		// A server that held the request already waited; poll again at once.
+		delay := jobPollInterval - time.Since(start)
+		if delay <= 0 {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
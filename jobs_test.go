@@ -0,0 +1,27 @@
+package actorhub
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestVerifyJobIsGenericJob(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/jobs/job_1" {
+			t.Errorf("path = %s, want /api/v1/jobs/job_1", r.URL.Path)
+		}
+		w.Write([]byte(`{"id":"job_1","type":"video_verification","status":"failed","error":{"code":"unreadable_media","message":"bad codec"}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", WithBaseURL(srv.URL), WithMaxRetries(1))
+	job, err := c.GetVerifyJob(context.Background(), "job_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Error == nil || job.Error.Code != "unreadable_media" {
+		t.Errorf("Error = %+v, want the structured job error", job.Error)
+	}
+}
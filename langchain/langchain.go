@@ -0,0 +1,206 @@
+// Package langchain adapts ActorHub checks to the LangChainGo tools.Tool
+// interface, so they can be handed to an existing agent:
+//
+//	agent := agents.NewOneShotAgent(llm, []tools.Tool{
+//	    langchain.Verify(client),
+//	    langchain.CheckConsent(client, actorhub.PlatformRunway, actorhub.IntendedUseVideo),
+//	    langchain.ScreenPrompt(client),
+//	})
+//
+// The package does not import LangChainGo; Tool satisfies its interface
+// structurally.
+package langchain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	actorhub "github.com/actorhubai/actorhub-go"
+)
+
+// maxInputSize bounds tool input from the model. Base64 images are expected
+// to be passed by URL instead.
+const maxInputSize = 64 * 1024
+
+// Tool is an ActorHub check usable as a LangChainGo tool.
+type Tool struct {
+	name        string
+	description string
+	call        func(ctx context.Context, input string) (interface{}, error)
+}
+
+// Name returns the tool name.
+func (t *Tool) Name() string {
+	return t.name
+}
+
+// Description tells the model when to use the tool and how to format input.
+func (t *Tool) Description() string {
+	return t.description
+}
+
+// Call runs the tool. Malformed or invalid input is reported back to the
+// model as the tool's output so it can correct itself; other API failures are
+// returned as errors.
+func (t *Tool) Call(ctx context.Context, input string) (string, error) {
+	input = cleanInput(input)
+	if input == "" {
+		return "invalid input: input is empty", nil
+	}
+	if len(input) > maxInputSize {
+		return "invalid input: input is too large; pass images by URL", nil
+	}
+
+	out, err := t.call(ctx, input)
+	if err != nil {
+		var inputErr *inputError
+		var validationErr *actorhub.ValidationError
+		switch {
+		case errors.As(err, &inputErr):
+			return "invalid input: " + inputErr.msg, nil
+		case errors.As(err, &validationErr):
+			return "invalid input: " + validationErr.Message, nil
+		}
+		return "", err
+	}
+
+	data, err := json.Marshal(out)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Verify returns a tool that checks an image for protected identities. Input
+// is an image URL or a JSON object with "image_url".
+func Verify(client *actorhub.Client) *Tool {
+	return &Tool{
+		name: "actorhub_verify_image",
+		description: "Checks whether an image contains the face of a protected real person. " +
+			"Use it on any image of a real person before editing, animating, or publishing it. " +
+			`Input: the image URL, or a JSON object like {"image_url": "https://..."}.`,
+		call: func(ctx context.Context, input string) (interface{}, error) {
+			var in imageInput
+			if err := parseInput(input, &in, func(s string) { in.ImageURL = s }); err != nil {
+				return nil, err
+			}
+			if in.ImageURL == "" && in.ImageBase64 == "" {
+				return nil, &inputError{"an image URL is required"}
+			}
+			return client.Verify(ctx, &actorhub.VerifyRequest{
+				ImageURL:    in.ImageURL,
+				ImageBase64: in.ImageBase64,
+			})
+		},
+	}
+}
+
+// CheckConsent returns a tool that checks whether the people in an image
+// consent to generation on platform for intendedUse. Input is an image URL
+// or a JSON object with "image_url" and optionally "region".
+func CheckConsent(client *actorhub.Client, platform actorhub.Platform, intendedUse actorhub.IntendedUse) *Tool {
+	return &Tool{
+		name: "actorhub_check_consent",
+		description: fmt.Sprintf("Checks whether the people in an image have consented to AI %s generation. ", intendedUse) +
+			"Use it before generating anything from a reference photo of a real person, and do not proceed if consent is missing. " +
+			`Input: the image URL, or a JSON object like {"image_url": "https://...", "region": "US"}.`,
+		call: func(ctx context.Context, input string) (interface{}, error) {
+			var in consentInput
+			if err := parseInput(input, &in, func(s string) { in.ImageURL = s }); err != nil {
+				return nil, err
+			}
+			if in.ImageURL == "" && in.ImageBase64 == "" {
+				return nil, &inputError{"an image URL is required"}
+			}
+			// Platform and intended use are the integrator's, never the model's.
+			return client.CheckConsent(ctx, &actorhub.ConsentCheckRequest{
+				ImageURL:    in.ImageURL,
+				ImageBase64: in.ImageBase64,
+				Region:      in.Region,
+				Platform:    platform,
+				IntendedUse: intendedUse,
+			})
+		},
+	}
+}
+
+// ScreenPrompt returns a tool that screens a generation prompt for protected
+// identities. Input is the prompt text.
+func ScreenPrompt(client *actorhub.Client) *Tool {
+	return &Tool{
+		name: "actorhub_screen_prompt",
+		description: "Screens an image, video, or voice generation prompt for references to protected real people and blocked content. " +
+			"Use it before sending any prompt that names a real person to a generation model. " +
+			"Input: the prompt text.",
+		call: func(ctx context.Context, input string) (interface{}, error) {
+			req := &actorhub.PromptScreenRequest{}
+			if err := parseInput(input, req, func(s string) { req.Prompt = s }); err != nil {
+				return nil, err
+			}
+			if strings.TrimSpace(req.Prompt) == "" {
+				return nil, &inputError{"a prompt is required"}
+			}
+			return client.ScreenPrompt(ctx, req)
+		},
+	}
+}
+
+// imageInput is the JSON input accepted by the image tools. Only fields the
+// model may choose are decoded; anything else is rejected.
+type imageInput struct {
+	ImageURL    string `json:"image_url"`
+	ImageBase64 string `json:"image_base64"`
+}
+
+// consentInput is the JSON input accepted by the consent tool.
+type consentInput struct {
+	ImageURL    string `json:"image_url"`
+	ImageBase64 string `json:"image_base64"`
+	Region      string `json:"region"`
+}
+
+// inputError reports tool input the model should correct.
+type inputError struct {
+	msg string
+}
+
+func (e *inputError) Error() string {
+	return e.msg
+}
+
+// parseInput decodes a JSON object input into req, or passes plain text to
+// setPlain. Models often wrap input in quotes or code fences; cleanInput has
+// already removed those.
+func parseInput(input string, req interface{}, setPlain func(string)) error {
+	if !strings.HasPrefix(input, "{") {
+		setPlain(input)
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader([]byte(input)))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(req); err != nil {
+		return &inputError{err.Error()}
+	}
+	return nil
+}
+
+// cleanInput strips code fences and surrounding quotes models add to input.
+func cleanInput(input string) string {
+	input = strings.TrimSpace(input)
+	if strings.HasPrefix(input, "```") {
+		input = strings.TrimPrefix(input, "```")
+		if i := strings.IndexByte(input, '\n'); i >= 0 && !strings.HasPrefix(strings.TrimSpace(input[:i]), "{") {
+			input = input[i+1:] // language tag
+		}
+		input = strings.TrimSuffix(strings.TrimSpace(input), "```")
+		input = strings.TrimSpace(input)
+	}
+	if len(input) >= 2 && (input[0] == '"' && input[len(input)-1] == '"' || input[0] == '\'' && input[len(input)-1] == '\'') {
+		input = strings.TrimSpace(input[1 : len(input)-1])
+	}
+	return input
+}
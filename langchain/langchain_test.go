@@ -0,0 +1,49 @@
+package langchain
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	actorhub "github.com/actorhubai/actorhub-go"
+)
+
+func TestCheckConsentKeepsFixedFields(t *testing.T) {
+	var sent []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req map[string]interface{}
+		json.Unmarshal(body, &req)
+		sent = append(sent, req)
+		w.Write([]byte(`{"protected":false,"faces":[]}`))
+	}))
+	defer srv.Close()
+	tool := CheckConsent(actorhub.NewClient("key", actorhub.WithBaseURL(srv.URL)), actorhub.PlatformRunway, actorhub.IntendedUseImage)
+	ctx := context.Background()
+
+	for _, input := range []string{
+		`{"image_url":"https://example.com/a.jpg","platform":"sora"}`,
+		`{"image_url":"https://example.com/a.jpg","intended_use":"ai_training"}`,
+		`{"image_url":"https://example.com/a.jpg","consent_token":"forged"}`,
+		`{"upload_id":"up_1"}`,
+	} {
+		out, err := tool.Call(ctx, input)
+		if err != nil || !strings.HasPrefix(out, "invalid input") {
+			t.Errorf("Call(%s) = %q, %v, want invalid input", input, out, err)
+		}
+	}
+	if len(sent) != 0 {
+		t.Fatalf("%d requests reached the API", len(sent))
+	}
+
+	if _, err := tool.Call(ctx, `{"image_url":"https://example.com/a.jpg","region":"US"}`); err != nil {
+		t.Fatal(err)
+	}
+	if len(sent) != 1 || sent[0]["platform"] != "runway" || sent[0]["intended_use"] != "image" || sent[0]["region"] != "US" {
+		t.Errorf("sent %v, want the configured platform and intended use with the model's region", sent)
+	}
+}
@@ -0,0 +1,121 @@
+package actorhub
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// DefaultListAllLimit is the most items a ListAll method collects.
+const DefaultListAllLimit = 10000
+
+// ErrListAllLimit is returned, along with the items collected so far, when a
+// list holds more items than a ListAll method may collect.
+var ErrListAllLimit = errors.New("actorhub: list exceeds the item limit")
+
+// All collects every remaining item of the pager, up to limit items, or
+// DefaultListAllLimit if limit <= 0. If the rate limit is exhausted between
+// pages, it waits for the window to reset rather than failing. If the list
+// holds more than limit items, it returns the first limit with
+// ErrListAllLimit; on any other error it returns the items collected before
+// it.
+func (p *Pager[T]) All(ctx context.Context, limit int) ([]T, error) {
+	if limit <= 0 {
+		limit = DefaultListAllLimit
+	}
+	var all []T
+	for first := true; ; first = false {
+		if !first {
+			if err := p.client.paceRateLimit(ctx); err != nil {
+				return all, err
+			}
+		}
+		if !p.Next(ctx) {
+			return all, p.Err()
+		}
+		page := p.Page()
+		if len(all)+len(page) > limit {
+			return append(all, page[:limit-len(all)]...), ErrListAllLimit
+		}
+		all = append(all, page...)
+		if len(all) == limit && !p.done {
+			// Only another page can tell whether the list ends here.
+			if err := p.client.paceRateLimit(ctx); err != nil {
+				return all, err
+			}
+			if p.Next(ctx) {
+				return all, ErrListAllLimit
+			}
+			return all, p.Err()
+		}
+	}
+}
+
+// paceRateLimit waits for the rate-limit window to reset if the last
+// response reported no requests left in it.
+func (c *Client) paceRateLimit(ctx context.Context) error {
+	state := c.rateLimit.get()
+	if state.ObservedAt.IsZero() || state.Remaining > 0 {
+		return nil
+	}
+	wait := time.Until(state.Reset)
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// ListAllMarketplace returns every marketplace listing matching req, up to
+// DefaultListAllLimit. See Pager.All.
+func (c *Client) ListAllMarketplace(ctx context.Context, req *MarketplaceListRequest) ([]MarketplaceListingResponse, error) {
+	return c.MarketplacePager(req).All(ctx, 0)
+}
+
+// ListAllLicenses returns every license purchased by the current user,
+// filtered by status if it is not empty, up to DefaultListAllLimit.
+func (c *Client) ListAllLicenses(ctx context.Context, status string) ([]LicenseResponse, error) {
+	return c.MyLicensesPager(status).All(ctx, 0)
+}
+
+// ListAllTakedowns returns every takedown case submitted by the current
+// account, filtered by status if it is not empty, up to DefaultListAllLimit.
+func (c *Client) ListAllTakedowns(ctx context.Context, status TakedownStatus) ([]Takedown, error) {
+	return c.TakedownsPager(status).All(ctx, 0)
+}
+
+// ListAllMonitorHits returns all content found by a monitor, up to
+// DefaultListAllLimit.
+func (c *Client) ListAllMonitorHits(ctx context.Context, monitorID string) ([]MonitorHit, error) {
+	return c.MonitorHitsPager(monitorID).All(ctx, 0)
+}
+
+// ListAllInvoices returns every billing invoice, filtered by status if it is
+// not empty, up to DefaultListAllLimit.
+func (c *Client) ListAllInvoices(ctx context.Context, status string) ([]Invoice, error) {
+	return c.InvoicesPager(status).All(ctx, 0)
+}
+
+// ListAllDisputes returns every dispute, filtered by status if it is not
+// empty, up to DefaultListAllLimit.
+func (c *Client) ListAllDisputes(ctx context.Context, status DisputeStatus) ([]Dispute, error) {
+	return c.DisputesPager(status).All(ctx, 0)
+}
+
+// ListAllPayouts returns every payout, filtered by status if it is not
+// empty, up to DefaultListAllLimit.
+func (c *Client) ListAllPayouts(ctx context.Context, status PayoutStatus) ([]Payout, error) {
+	return c.PayoutsPager(status).All(ctx, 0)
+}
+
+// ListAllTransactions returns every transaction matching req, up to
+// DefaultListAllLimit.
+func (c *Client) ListAllTransactions(ctx context.Context, req *TransactionListRequest) ([]Transaction, error) {
+	return c.TransactionsPager(req).All(ctx, 0)
+}
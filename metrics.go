@@ -0,0 +1,102 @@
+package actorhub
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// MetricsCollector receives a callback when each client call starts and
+// completes, for recording rate, error, and duration metrics per endpoint.
+// Implementations must be safe for concurrent use. See the metrics/prometheus
+// module for a Prometheus implementation.
+//
+// endpoint is the request path with IDs replaced by "{id}", such as
+// "/api/v1/actor-packs/{id}/versions", so it is safe to use as a metric
+// label.
+type MetricsCollector interface {
+	// RequestStarted is called before the first attempt of a call.
+	RequestStarted(method, endpoint string)
+
+	// RequestCompleted is called once a call succeeds or fails for good.
+	// statusCode is that of the final response, or zero if no response was
+	// received; retries is the number of attempts after the first.
+	RequestCompleted(method, endpoint string, statusCode int, duration time.Duration, retries int)
+}
+
+// WithMetrics reports every call the client makes to m.
+func WithMetrics(m MetricsCollector) ClientOption {
+	return func(c *Client) {
+		c.metrics = m
+	}
+}
+
+// endpointName returns path without its query and with IDs replaced.
+func endpointName(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if isIDSegment(segment) || i > 0 && segments[i-1] == "handle" {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// isIDSegment reports whether a path segment identifies a resource rather
+// than names an endpoint. Endpoint names never contain digits, apart from
+// the API version; handles, which may not contain digits either, are
+// recognized by their position instead.
+func isIDSegment(segment string) bool {
+	if len(segment) > 32 {
+		return true
+	}
+	if len(segment) > 1 && segment[0] == 'v' && strings.Trim(segment[1:], "0123456789") == "" {
+		return false
+	}
+	return strings.ContainsAny(segment, "0123456789")
+}
+
+// callStats tracks a call across its attempts.
+type callStats struct {
+	method     string
+	endpoint   string
+	start      time.Time
+	retries    int
+	statusCode atomic.Int32 // of the latest response; hedges may race
+}
+
+type callStatsKey struct{}
+
+// startCall begins tracking a call for the client's statistics and metrics.
+func (c *Client) startCall(ctx context.Context, method, path string) (context.Context, *callStats) {
+	call := &callStats{method: method, endpoint: endpointName(path), start: time.Now()}
+	if c.metrics != nil {
+		c.metrics.RequestStarted(call.method, call.endpoint)
+	}
+	return context.WithValue(ctx, callStatsKey{}, call), call
+}
+
+// finishCall records the outcome of a tracked call.
+func (c *Client) finishCall(call *callStats, err error) {
+	statusCode := int(call.statusCode.Load())
+	var e apiError
+	if err != nil && errors.As(err, &e) && e.apiError().StatusCode > 0 {
+		statusCode = e.apiError().StatusCode
+	}
+	duration := time.Since(call.start)
+	c.stats.record(call.method, call.endpoint, duration, err != nil && isServiceError(statusCode))
+	if c.metrics != nil {
+		c.metrics.RequestCompleted(call.method, call.endpoint, statusCode, duration, call.retries)
+	}
+}
+
+// callStatsFrom returns the call tracked by ctx, or nil.
+func callStatsFrom(ctx context.Context) *callStats {
+	call, _ := ctx.Value(callStatsKey{}).(*callStats)
+	return call
+}
@@ -0,0 +1,142 @@
+// Package prometheus records ActorHub client metrics with Prometheus.
+//
+// It implements actorhub.MetricsCollector, exposing request counts, latency,
+// retries, and in-flight requests per endpoint:
+//
+//	collector := prometheus.New()
+//	registry.MustRegister(collector)
+//
+//	client := actorhub.NewClient(apiKey, actorhub.WithMetrics(collector))
+//
+// It is a separate module so that the SDK itself does not depend on the
+// Prometheus client library.
+package prometheus
+
+import (
+	"strconv"
+	"time"
+
+	actorhub "github.com/actorhubai/actorhub-go"
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// Collector records ActorHub client metrics. It implements both
+// actorhub.MetricsCollector and prometheus.Collector.
+type Collector struct {
+	requests *prom.CounterVec
+	duration *prom.HistogramVec
+	retries  *prom.CounterVec
+	inFlight *prom.GaugeVec
+}
+
+var (
+	_ actorhub.MetricsCollector = (*Collector)(nil)
+	_ prom.Collector            = (*Collector)(nil)
+)
+
+type config struct {
+	namespace   string
+	buckets     []float64
+	constLabels prom.Labels
+}
+
+// Option configures a Collector.
+type Option func(*config)
+
+// WithNamespace sets the metric namespace, "actorhub" by default.
+func WithNamespace(namespace string) Option {
+	return func(c *config) {
+		c.namespace = namespace
+	}
+}
+
+// WithBuckets sets the request duration histogram buckets, in seconds.
+func WithBuckets(buckets []float64) Option {
+	return func(c *config) {
+		c.buckets = buckets
+	}
+}
+
+// WithConstLabels adds labels with fixed values to every metric, for example
+// to tell apart several clients in one process.
+func WithConstLabels(labels prom.Labels) Option {
+	return func(c *config) {
+		c.constLabels = labels
+	}
+}
+
+// New creates a Collector. Register it with a prometheus.Registerer before
+// use.
+func New(opts ...Option) *Collector {
+	cfg := config{
+		namespace: "actorhub",
+		buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	labels := []string{"method", "endpoint"}
+	return &Collector{
+		requests: prom.NewCounterVec(prom.CounterOpts{
+			Namespace:   cfg.namespace,
+			Subsystem:   "client",
+			Name:        "requests_total",
+			Help:        "Completed ActorHub API calls by status code; code 0 means no response.",
+			ConstLabels: cfg.constLabels,
+		}, append(labels, "code")),
+		duration: prom.NewHistogramVec(prom.HistogramOpts{
+			Namespace:   cfg.namespace,
+			Subsystem:   "client",
+			Name:        "request_duration_seconds",
+			Help:        "Duration of ActorHub API calls, including retries.",
+			Buckets:     cfg.buckets,
+			ConstLabels: cfg.constLabels,
+		}, labels),
+		retries: prom.NewCounterVec(prom.CounterOpts{
+			Namespace:   cfg.namespace,
+			Subsystem:   "client",
+			Name:        "retries_total",
+			Help:        "Retried attempts of ActorHub API calls.",
+			ConstLabels: cfg.constLabels,
+		}, labels),
+		inFlight: prom.NewGaugeVec(prom.GaugeOpts{
+			Namespace:   cfg.namespace,
+			Subsystem:   "client",
+			Name:        "requests_in_flight",
+			Help:        "ActorHub API calls in progress.",
+			ConstLabels: cfg.constLabels,
+		}, labels),
+	}
+}
+
+// RequestStarted implements actorhub.MetricsCollector.
+func (c *Collector) RequestStarted(method, endpoint string) {
+	c.inFlight.WithLabelValues(method, endpoint).Inc()
+}
+
+// RequestCompleted implements actorhub.MetricsCollector.
+func (c *Collector) RequestCompleted(method, endpoint string, statusCode int, duration time.Duration, retries int) {
+	c.inFlight.WithLabelValues(method, endpoint).Dec()
+	c.requests.WithLabelValues(method, endpoint, strconv.Itoa(statusCode)).Inc()
+	c.duration.WithLabelValues(method, endpoint).Observe(duration.Seconds())
+	if retries > 0 {
+		c.retries.WithLabelValues(method, endpoint).Add(float64(retries))
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prom.Desc) {
+	c.requests.Describe(ch)
+	c.duration.Describe(ch)
+	c.retries.Describe(ch)
+	c.inFlight.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prom.Metric) {
+	c.requests.Collect(ch)
+	c.duration.Collect(ch)
+	c.retries.Collect(ch)
+	c.inFlight.Collect(ch)
+}
@@ -1,7 +1,12 @@
 // Package actorhub provides a Go client for the ActorHub.ai API.
 package actorhub
 
-import "time"
+import (
+	"io"
+	"net/url"
+	"strings"
+	"time"
+)
 
 // TrainingStatus represents the status of an Actor Pack training job.
 type TrainingStatus string
@@ -41,6 +46,260 @@ const (
 	UsageTypeEducational UsageType = "educational"
 )
 
+// TrendingWindow represents the time window used to rank trending listings.
+type TrendingWindow string
+
+const (
+	TrendingWindowDay   TrendingWindow = "24h"
+	TrendingWindowWeek  TrendingWindow = "7d"
+	TrendingWindowMonth TrendingWindow = "30d"
+)
+
+// CheckoutStatus represents the status of a checkout session.
+type CheckoutStatus string
+
+const (
+	CheckoutStatusOpen     CheckoutStatus = "open"
+	CheckoutStatusComplete CheckoutStatus = "complete"
+	CheckoutStatusExpired  CheckoutStatus = "expired"
+)
+
+// DisputeTargetType represents the kind of record a dispute is opened against.
+type DisputeTargetType string
+
+const (
+	DisputeTargetVerification DisputeTargetType = "verification"
+	DisputeTargetTakedown     DisputeTargetType = "takedown"
+)
+
+// DisputeStatus represents the status of a dispute.
+type DisputeStatus string
+
+const (
+	DisputeStatusOpen        DisputeStatus = "open"
+	DisputeStatusUnderReview DisputeStatus = "under_review"
+	DisputeStatusResolved    DisputeStatus = "resolved"
+	DisputeStatusRejected    DisputeStatus = "rejected"
+)
+
+// Granularity represents the bucket size of time-series report data.
+type Granularity string
+
+const (
+	GranularityDay   Granularity = "day"
+	GranularityWeek  Granularity = "week"
+	GranularityMonth Granularity = "month"
+)
+
+// Period represents a reporting time range. Zero fields use the server defaults.
+type Period struct {
+	Start       time.Time
+	End         time.Time
+	Granularity Granularity
+}
+
+// values encodes the period as query parameters.
+func (p Period) values() url.Values {
+	params := url.Values{}
+	if !p.Start.IsZero() {
+		params.Set("start", p.Start.UTC().Format(time.RFC3339))
+	}
+	if !p.End.IsZero() {
+		params.Set("end", p.End.UTC().Format(time.RFC3339))
+	}
+	if p.Granularity != "" {
+		params.Set("granularity", string(p.Granularity))
+	}
+	return params
+}
+
+// PayoutStatus represents the status of a creator payout.
+type PayoutStatus string
+
+const (
+	PayoutStatusPending   PayoutStatus = "pending"
+	PayoutStatusInTransit PayoutStatus = "in_transit"
+	PayoutStatusPaid      PayoutStatus = "paid"
+	PayoutStatusFailed    PayoutStatus = "failed"
+)
+
+// TransactionType represents the kind of money movement in a transaction.
+type TransactionType string
+
+const (
+	TransactionTypePurchase TransactionType = "purchase"
+	TransactionTypeRefund   TransactionType = "refund"
+	TransactionTypePayout   TransactionType = "payout"
+)
+
+// APIKeyScope represents a permission granted to an API key.
+type APIKeyScope string
+
+const (
+	APIKeyScopeVerify      APIKeyScope = "verify"
+	APIKeyScopeConsent     APIKeyScope = "consent"
+	APIKeyScopeMarketplace APIKeyScope = "marketplace"
+	APIKeyScopeBilling     APIKeyScope = "billing"
+	APIKeyScopeAdmin       APIKeyScope = "admin"
+)
+
+// TakedownStatus represents the status of a takedown case.
+type TakedownStatus string
+
+const (
+	TakedownStatusSubmitted TakedownStatus = "submitted"
+	TakedownStatusNotified  TakedownStatus = "notified"
+	TakedownStatusRemoved   TakedownStatus = "removed"
+	TakedownStatusRejected  TakedownStatus = "rejected"
+)
+
+// DataDeletionStatus represents the status of a data deletion request.
+type DataDeletionStatus string
+
+const (
+	DataDeletionStatusReceived   DataDeletionStatus = "received"
+	DataDeletionStatusProcessing DataDeletionStatus = "processing"
+	DataDeletionStatusCompleted  DataDeletionStatus = "completed"
+	DataDeletionStatusRejected   DataDeletionStatus = "rejected"
+)
+
+// ReportFormat represents the file format of an exported report.
+type ReportFormat string
+
+const (
+	ReportFormatJSON   ReportFormat = "json"
+	ReportFormatCSV    ReportFormat = "csv"
+	ReportFormatPDF    ReportFormat = "pdf"
+	ReportFormatNDJSON ReportFormat = "ndjson"
+)
+
+// AgeAssuranceStatus represents the outcome of age estimation for a face.
+type AgeAssuranceStatus string
+
+const (
+	AgeAssuranceAdult       AgeAssuranceStatus = "adult"
+	AgeAssuranceMinor       AgeAssuranceStatus = "minor"
+	AgeAssuranceUncertain   AgeAssuranceStatus = "uncertain"
+	AgeAssuranceNotAssessed AgeAssuranceStatus = "not_assessed"
+)
+
+func (s AgeAssuranceStatus) potentialMinor() bool {
+	return s == AgeAssuranceMinor || s == AgeAssuranceUncertain
+}
+
+// ClaimStatus represents the status of an identity claim.
+type ClaimStatus string
+
+const (
+	ClaimStatusLivenessRequired ClaimStatus = "liveness_required"
+	ClaimStatusEvidenceRequired ClaimStatus = "evidence_required"
+	ClaimStatusUnderReview      ClaimStatus = "under_review"
+	ClaimStatusApproved         ClaimStatus = "approved"
+	ClaimStatusRejected         ClaimStatus = "rejected"
+)
+
+// KYCDocumentType represents the kind of identity verification document.
+type KYCDocumentType string
+
+const (
+	KYCDocumentPassport            KYCDocumentType = "passport"
+	KYCDocumentNationalID          KYCDocumentType = "national_id"
+	KYCDocumentDriversLicense      KYCDocumentType = "drivers_license"
+	KYCDocumentAgencyAuthorization KYCDocumentType = "agency_authorization"
+)
+
+// KYCStatus represents the review status of a KYC document.
+type KYCStatus string
+
+const (
+	KYCStatusPending  KYCStatus = "pending"
+	KYCStatusInReview KYCStatus = "in_review"
+	KYCStatusVerified KYCStatus = "verified"
+	KYCStatusRejected KYCStatus = "rejected"
+)
+
+// MotionDataKind represents the kind of motion data supplied for an Actor Pack.
+type MotionDataKind string
+
+const (
+	MotionDataCapture        MotionDataKind = "motion_capture"
+	MotionDataReferenceVideo MotionDataKind = "reference_video"
+)
+
+// ModelFormat represents an export format for Actor Pack model weights.
+type ModelFormat string
+
+const (
+	ModelFormatSafetensors ModelFormat = "safetensors"
+	ModelFormatDiffusers   ModelFormat = "diffusers"
+	ModelFormatONNX        ModelFormat = "onnx"
+)
+
+// EntitlementDenialReason represents why an Actor Pack download is not allowed.
+type EntitlementDenialReason string
+
+const (
+	EntitlementNoLicense      EntitlementDenialReason = "no_license"
+	EntitlementLicenseExpired EntitlementDenialReason = "license_expired"
+	EntitlementRegionBlocked  EntitlementDenialReason = "region_blocked"
+	EntitlementPackArchived   EntitlementDenialReason = "pack_archived"
+)
+
+// Platform identifies a generation platform in consent checks.
+type Platform string
+
+const (
+	PlatformRunway    Platform = "runway"
+	PlatformPika      Platform = "pika"
+	PlatformKling     Platform = "kling"
+	PlatformSora      Platform = "sora"
+	PlatformOpenAI    Platform = "openai"
+	PlatformStability Platform = "stability"
+	PlatformComfyUI   Platform = "comfyui"
+)
+
+// IntendedUse represents how generated output will be used.
+type IntendedUse string
+
+const (
+	IntendedUseImage      IntendedUse = "image"
+	IntendedUseVideo      IntendedUse = "video"
+	IntendedUseAudio      IntendedUse = "audio"
+	IntendedUseAITraining IntendedUse = "ai_training"
+)
+
+// OutputViolationReason represents why a generated output is not covered by
+// its licenses.
+type OutputViolationReason string
+
+const (
+	OutputViolationUnlicensed     OutputViolationReason = "unlicensed_identity"
+	OutputViolationLicenseExpired OutputViolationReason = "license_expired"
+	OutputViolationOutOfScope     OutputViolationReason = "license_out_of_scope"
+)
+
+// JobStatus represents the status of an asynchronous job.
+type JobStatus string
+
+const (
+	JobStatusQueued    JobStatus = "queued"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusCanceled  JobStatus = "canceled"
+)
+
+// ServiceHealth represents the health of the API or one of its components.
+type ServiceHealth string
+
+const (
+	ServiceOperational   ServiceHealth = "operational"
+	ServiceDegraded      ServiceHealth = "degraded_performance"
+	ServicePartialOutage ServiceHealth = "partial_outage"
+	ServiceMajorOutage   ServiceHealth = "major_outage"
+	ServiceMaintenance   ServiceHealth = "under_maintenance"
+)
+
 // FaceBBox represents face bounding box coordinates.
 type FaceBBox struct {
 	X      float64 `json:"x"`
@@ -52,7 +311,7 @@ type FaceBBox struct {
 // LicenseOption represents a license option with pricing.
 type LicenseOption struct {
 	Type           LicenseType `json:"type"`
-	PriceUSD       float64     `json:"price_usd"`
+	PriceUSD       Money       `json:"price_usd"`
 	DurationDays   int         `json:"duration_days"`
 	MaxImpressions *int        `json:"max_impressions,omitempty"`
 }
@@ -67,6 +326,14 @@ type VerifyResult struct {
 	BlockedCategories []string        `json:"blocked_categories"`
 	LicenseOptions    []LicenseOption `json:"license_options"`
 	FaceBBox          *FaceBBox       `json:"face_bbox,omitempty"`
+
+	EstimatedMinor     bool               `json:"estimated_minor"`
+	AgeAssuranceStatus AgeAssuranceStatus `json:"age_assurance_status,omitempty"`
+}
+
+// PotentialMinor reports whether the face may belong to a minor.
+func (r VerifyResult) PotentialMinor() bool {
+	return r.EstimatedMinor || r.AgeAssuranceStatus.potentialMinor()
 }
 
 // VerifyResponse is the response from identity verification.
@@ -78,6 +345,62 @@ type VerifyResponse struct {
 	RequestID      string         `json:"request_id"`
 }
 
+// VideoFrame represents a sampled video frame in which an identity was matched.
+type VideoFrame struct {
+	Timestamp       float64   `json:"timestamp"` // seconds from the start of the video
+	SimilarityScore float64   `json:"similarity_score"`
+	FaceBBox        *FaceBBox `json:"face_bbox,omitempty"`
+}
+
+// VideoSegment represents a time range in which an identity appears.
+type VideoSegment struct {
+	Start  float64      `json:"start"` // seconds from the start of the video
+	End    float64      `json:"end"`
+	Frames []VideoFrame `json:"frames"`
+}
+
+// VideoIdentityMatch represents an identity matched in a video. The embedded
+// VerifyResult carries the highest similarity across all frames.
+type VideoIdentityMatch struct {
+	VerifyResult
+	Segments []VideoSegment `json:"segments"`
+}
+
+// VideoVerifyResponse is the response from video verification.
+type VideoVerifyResponse struct {
+	Protected       bool                 `json:"protected"`
+	DurationSeconds float64              `json:"duration_seconds"`
+	FramesSampled   int                  `json:"frames_sampled"`
+	Identities      []VideoIdentityMatch `json:"identities"`
+	ResponseTimeMs  int                  `json:"response_time_ms"`
+	RequestID       string               `json:"request_id"`
+}
+
+// VerifyJob represents an asynchronous verification job. Its result is a
+// VideoVerifyResponse.
+//
+// Deprecated: Use Job, which SubmitVerifyJob and GetVerifyJob return.
+type VerifyJob = Job
+
+// OutputViolation represents a protected identity in a generated output that
+// the supplied licenses do not cover.
+type OutputViolation struct {
+	IdentityID      string                `json:"identity_id"`
+	DisplayName     *string               `json:"display_name,omitempty"`
+	SimilarityScore float64               `json:"similarity_score"`
+	Reason          OutputViolationReason `json:"reason"`
+	LicenseID       *string               `json:"license_id,omitempty"` // the license that failed, if any
+	FaceBBox        *FaceBBox             `json:"face_bbox,omitempty"`
+}
+
+// OutputCheckResult is the response from checking a generated output.
+type OutputCheckResult struct {
+	Allowed    bool              `json:"allowed"`
+	Violations []OutputViolation `json:"violations"`
+	Identities []VerifyResult    `json:"identities"`
+	RequestID  string            `json:"request_id"`
+}
+
 // ConsentDetails represents consent permissions for an identity.
 type ConsentDetails struct {
 	CommercialUse   bool `json:"commercial_use"`
@@ -95,9 +418,9 @@ type ConsentRestrictions struct {
 
 // ConsentLicenseInfo represents license availability information.
 type ConsentLicenseInfo struct {
-	Available bool                `json:"available"`
-	URL       *string             `json:"url,omitempty"`
-	Pricing   map[string]float64  `json:"pricing,omitempty"`
+	Available bool             `json:"available"`
+	URL       *string          `json:"url,omitempty"`
+	Pricing   map[string]Money `json:"pricing,omitempty"`
 }
 
 // ConsentTokenResult represents the consent token verification included in response.
@@ -122,6 +445,14 @@ type TrustSignature struct {
 	Algorithm       string `json:"algorithm"`
 }
 
+// RegionConsent represents the consent decision for a single region.
+type RegionConsent struct {
+	Region  string         `json:"region"`
+	Allowed bool           `json:"allowed"`
+	Consent ConsentDetails `json:"consent"`
+	Reasons []string       `json:"reasons,omitempty"`
+}
+
 // ConsentResult represents an individual consent check result.
 type ConsentResult struct {
 	Protected       bool                 `json:"protected"`
@@ -132,6 +463,27 @@ type ConsentResult struct {
 	Restrictions    ConsentRestrictions  `json:"restrictions"`
 	License         ConsentLicenseInfo   `json:"license"`
 	Token           *ConsentTokenResult  `json:"token,omitempty"`
+
+	EstimatedMinor     bool               `json:"estimated_minor"`
+	AgeAssuranceStatus AgeAssuranceStatus `json:"age_assurance_status,omitempty"`
+
+	// Regions holds per-region decisions when ConsentCheckRequest.Regions is set.
+	Regions []RegionConsent `json:"regions,omitempty"`
+}
+
+// RegionDecision returns the consent decision for a region, if it was requested.
+func (r ConsentResult) RegionDecision(region string) (RegionConsent, bool) {
+	for _, rc := range r.Regions {
+		if strings.EqualFold(rc.Region, region) {
+			return rc, true
+		}
+	}
+	return RegionConsent{}, false
+}
+
+// PotentialMinor reports whether the face may belong to a minor.
+func (r ConsentResult) PotentialMinor() bool {
+	return r.EstimatedMinor || r.AgeAssuranceStatus.potentialMinor()
 }
 
 // ConsentCheckResponse is the response from consent check.
@@ -143,40 +495,243 @@ type ConsentCheckResponse struct {
 	ResponseTimeMs     int             `json:"response_time_ms"`
 	RateLimitRemaining *int            `json:"rate_limit_remaining,omitempty"`
 	Trust              *TrustSignature `json:"trust,omitempty"`
+	ReceiptJWT         string          `json:"receipt_jwt,omitempty"` // set when IncludeReceipt is requested
+
+	// Stale is set when the API was unavailable and this response was served
+	// from the cache enabled by WithStaleConsentFallback; StaleAge is its age.
+	Stale    bool          `json:"-"`
+	StaleAge time.Duration `json:"-"`
+}
+
+// ConsentChange represents a change to an identity's consent settings.
+type ConsentChange struct {
+	ID            string              `json:"id"`
+	IdentityID    string              `json:"identity_id"`
+	ChangedFields []string            `json:"changed_fields"`
+	Previous      ConsentDetails      `json:"previous"`
+	Current       ConsentDetails      `json:"current"`
+	Restrictions  ConsentRestrictions `json:"restrictions"`
+	ChangedAt     Time                `json:"changed_at"`
+}
+
+// Revoked reports whether the change withdrew any previously granted consent.
+func (c ConsentChange) Revoked() bool {
+	return (c.Previous.CommercialUse && !c.Current.CommercialUse) ||
+		(c.Previous.AITraining && !c.Current.AITraining) ||
+		(c.Previous.VideoGeneration && !c.Current.VideoGeneration) ||
+		(c.Previous.Deepfake && !c.Current.Deepfake)
 }
 
 // IdentityResponse represents identity details.
 type IdentityResponse struct {
 	ID                 string          `json:"id"`
 	DisplayName        string          `json:"display_name"`
+	Handle             string          `json:"handle,omitempty"`
 	ProfileImageURL    *string         `json:"profile_image_url,omitempty"`
 	Status             string          `json:"status"`
 	ProtectionLevel    ProtectionLevel `json:"protection_level"`
 	ProtectionMode     string          `json:"protection_mode"`
 	TotalVerifications int             `json:"total_verifications"`
 	TotalLicenses      int             `json:"total_licenses"`
-	TotalRevenue       float64         `json:"total_revenue"`
+	TotalRevenue       Money           `json:"total_revenue"`
 	AllowCommercial    bool            `json:"allow_commercial"`
 	AllowAITraining    bool            `json:"allow_ai_training"`
-	CreatedAt          *time.Time      `json:"created_at,omitempty"`
+	CreatedAt          *Time           `json:"created_at,omitempty"`
+}
+
+// IdentityLookup represents the result for one ID in a batch identity lookup.
+type IdentityLookup struct {
+	ID       string            `json:"id"`
+	Found    bool              `json:"found"`
+	Identity *IdentityResponse `json:"identity,omitempty"`
+}
+
+// LivenessChallenge references a selfie-liveness session the claimant must complete.
+type LivenessChallenge struct {
+	SessionID    string  `json:"session_id"`
+	URL          string  `json:"url"`
+	Instructions *string `json:"instructions,omitempty"`
+	ExpiresAt    *Time   `json:"expires_at,omitempty"`
+}
+
+// IdentityClaim represents a person's claim to an identity record.
+type IdentityClaim struct {
+	ID                string             `json:"id"`
+	IdentityID        *string            `json:"identity_id,omitempty"`
+	Status            ClaimStatus        `json:"status"`
+	LivenessChallenge *LivenessChallenge `json:"liveness_challenge,omitempty"`
+	RequiredEvidence  []string           `json:"required_evidence"`
+	RejectionReason   *string            `json:"rejection_reason,omitempty"`
+	CreatedAt         *Time              `json:"created_at,omitempty"`
+	UpdatedAt         *Time              `json:"updated_at,omitempty"`
+}
+
+// KYCDocument represents an uploaded identity verification document.
+type KYCDocument struct {
+	ID              string          `json:"id"`
+	IdentityID      string          `json:"identity_id"`
+	DocumentType    KYCDocumentType `json:"document_type"`
+	Status          KYCStatus       `json:"status"`
+	RejectionReason *string         `json:"rejection_reason,omitempty"`
+	CreatedAt       *Time           `json:"created_at,omitempty"`
+	ReviewedAt      *Time           `json:"reviewed_at,omitempty"`
+}
+
+// Upload is a presigned upload slot for large media, see CreateUpload.
+type Upload struct {
+	UploadID  string            `json:"upload_id"`
+	URL       string            `json:"url"`               // presigned URL to PUT the bytes to
+	Headers   map[string]string `json:"headers,omitempty"` // extra headers the PUT must carry
+	ExpiresAt Time              `json:"expires_at"`
+}
+
+// VoiceSample represents an enrolled voice sample for an identity.
+type VoiceSample struct {
+	ID                   string   `json:"id"`
+	IdentityID           string   `json:"identity_id"`
+	Status               string   `json:"status"`
+	DurationSeconds      float64  `json:"duration_seconds"`
+	QualityScore         *float64 `json:"quality_score,omitempty"`
+	TotalEnrolledSeconds float64  `json:"total_enrolled_seconds"` // across all samples for the identity
+	VoicePrintReady      bool     `json:"voice_print_ready"`
+	CreatedAt            *Time    `json:"created_at,omitempty"`
+}
+
+// VoiceMatch represents a protected voice matched in an audio sample.
+type VoiceMatch struct {
+	IdentityID      string  `json:"identity_id"`
+	DisplayName     *string `json:"display_name,omitempty"`
+	SimilarityScore float64 `json:"similarity_score"`
+}
+
+// VoiceCloneDetection is the response from audio deepfake detection.
+type VoiceCloneDetection struct {
+	SyntheticProbability float64      `json:"synthetic_probability"` // 0 to 1
+	IsSynthetic          bool         `json:"is_synthetic"`
+	MatchedVoices        []VoiceMatch `json:"matched_voices"`
+	DurationSeconds      float64      `json:"duration_seconds"`
+	ResponseTimeMs       int          `json:"response_time_ms"`
+	RequestID            string       `json:"request_id"`
+}
+
+// VoiceVerifyResult represents an individual voice verification result.
+type VoiceVerifyResult struct {
+	Protected         bool            `json:"protected"`
+	IdentityID        *string         `json:"identity_id,omitempty"`
+	SimilarityScore   *float64        `json:"similarity_score,omitempty"`
+	DisplayName       *string         `json:"display_name,omitempty"`
+	LicenseRequired   bool            `json:"license_required"`
+	BlockedCategories []string        `json:"blocked_categories"`
+	LicenseOptions    []LicenseOption `json:"license_options"`
+}
+
+// VoiceVerifyResponse is the response from voice verification.
+type VoiceVerifyResponse struct {
+	Protected       bool                `json:"protected"`
+	DurationSeconds float64             `json:"duration_seconds"`
+	Identities      []VoiceVerifyResult `json:"identities"`
+	ResponseTimeMs  int                 `json:"response_time_ms"`
+	RequestID       string              `json:"request_id"`
+}
+
+// MultiModalMatch represents an identity matched across face and voice.
+// FaceConfidence or VoiceConfidence is nil when that modality did not match.
+type MultiModalMatch struct {
+	IdentityID         string             `json:"identity_id"`
+	DisplayName        *string            `json:"display_name,omitempty"`
+	Protected          bool               `json:"protected"`
+	Confidence         float64            `json:"confidence"` // combined across modalities
+	FaceConfidence     *float64           `json:"face_confidence,omitempty"`
+	VoiceConfidence    *float64           `json:"voice_confidence,omitempty"`
+	FaceBBox           *FaceBBox          `json:"face_bbox,omitempty"`
+	LicenseRequired    bool               `json:"license_required"`
+	BlockedCategories  []string           `json:"blocked_categories"`
+	LicenseOptions     []LicenseOption    `json:"license_options"`
+	EstimatedMinor     bool               `json:"estimated_minor"`
+	AgeAssuranceStatus AgeAssuranceStatus `json:"age_assurance_status,omitempty"`
+}
+
+// PotentialMinor reports whether the matched face may belong to a minor.
+func (m MultiModalMatch) PotentialMinor() bool {
+	return m.EstimatedMinor || m.AgeAssuranceStatus.potentialMinor()
+}
+
+// MultiModalVerifyResponse is the response from multi-modal verification.
+type MultiModalVerifyResponse struct {
+	Protected      bool              `json:"protected"`
+	Identities     []MultiModalMatch `json:"identities"`
+	ResponseTimeMs int               `json:"response_time_ms"`
+	RequestID      string            `json:"request_id"`
+}
+
+// PlatformProfile describes how consent checks are expressed for a platform.
+type PlatformProfile struct {
+	Platform           Platform      `json:"platform"`
+	DisplayName        string        `json:"display_name"`
+	IntendedUses       []IntendedUse `json:"intended_uses"` // canonical intended uses for the platform
+	DefaultIntendedUse IntendedUse   `json:"default_intended_use"`
+	RequiredFields     []string      `json:"required_fields"` // ConsentCheckRequest fields the platform requires, e.g. "region"
+}
+
+// PromptIdentityMatch represents a protected identity referenced by a prompt.
+type PromptIdentityMatch struct {
+	IdentityID      string         `json:"identity_id"`
+	DisplayName     *string        `json:"display_name,omitempty"`
+	MatchedText     string         `json:"matched_text"` // the span of the prompt naming the identity
+	Protected       bool           `json:"protected"`
+	LicenseRequired bool           `json:"license_required"`
+	Consent         ConsentDetails `json:"consent"`
+}
+
+// PromptScreenResult is the response from prompt screening.
+type PromptScreenResult struct {
+	Flagged        bool                  `json:"flagged"`
+	Identities     []PromptIdentityMatch `json:"identities"`
+	Categories     []string              `json:"categories"` // content categories detected in the prompt
+	ResponseTimeMs int                   `json:"response_time_ms"`
+	RequestID      string                `json:"request_id"`
 }
 
 // MarketplaceListingResponse represents marketplace listing details.
 type MarketplaceListingResponse struct {
-	ID              string     `json:"id"`
-	IdentityID      string     `json:"identity_id"`
-	Title           string     `json:"title"`
-	Description     *string    `json:"description,omitempty"`
-	Category        string     `json:"category"`
-	Tags            []string   `json:"tags"`
-	BasePriceUSD    float64    `json:"base_price_usd"`
-	DisplayName     string     `json:"display_name"`
-	ProfileImageURL *string    `json:"profile_image_url,omitempty"`
-	Featured        bool       `json:"featured"`
-	ViewCount       int        `json:"view_count"`
-	LicenseCount    int        `json:"license_count"`
-	Rating          *float64   `json:"rating,omitempty"`
-	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	ID              string   `json:"id"`
+	IdentityID      string   `json:"identity_id"`
+	Title           string   `json:"title"`
+	Description     *string  `json:"description,omitempty"`
+	Category        string   `json:"category"`
+	Tags            []string `json:"tags"`
+	BasePriceUSD    Money    `json:"base_price_usd"`
+	DisplayName     string   `json:"display_name"`
+	ProfileImageURL *string  `json:"profile_image_url,omitempty"`
+	Featured        bool     `json:"featured"`
+	ViewCount       int      `json:"view_count"`
+	LicenseCount    int      `json:"license_count"`
+	Rating          *float64 `json:"rating,omitempty"`
+	CreatedAt       *Time    `json:"created_at,omitempty"`
+}
+
+// CategoryCount represents a marketplace category and its listing count.
+type CategoryCount struct {
+	Category string `json:"category"`
+	Label    string `json:"label"`
+	Count    int    `json:"count"`
+}
+
+// TagCount represents a marketplace tag and its listing count.
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+// SimilarIdentity represents a marketplace identity matched by facial similarity.
+type SimilarIdentity struct {
+	IdentityID       string  `json:"identity_id"`
+	DisplayName      string  `json:"display_name"`
+	ProfileImageURL  *string `json:"profile_image_url,omitempty"`
+	SimilarityScore  float64 `json:"similarity_score"`
+	ListingID        *string `json:"listing_id,omitempty"`
+	BasePriceUSD     *Money  `json:"base_price_usd,omitempty"`
+	LicenseAvailable bool    `json:"license_available"`
 }
 
 // LicenseResponse represents license details.
@@ -192,10 +747,10 @@ type LicenseResponse struct {
 	AllowedPlatforms   []string    `json:"allowed_platforms"`
 	MaxImpressions     *int        `json:"max_impressions,omitempty"`
 	MaxOutputs         *int        `json:"max_outputs,omitempty"`
-	PriceUSD           float64     `json:"price_usd"`
-	StartsAt           *time.Time  `json:"starts_at,omitempty"`
-	ExpiresAt          *time.Time  `json:"expires_at,omitempty"`
-	CreatedAt          *time.Time  `json:"created_at,omitempty"`
+	PriceUSD           Money       `json:"price_usd"`
+	StartsAt           *Time       `json:"starts_at,omitempty"`
+	ExpiresAt          *Time       `json:"expires_at,omitempty"`
+	CreatedAt          *Time       `json:"created_at,omitempty"`
 }
 
 // ActorPackComponents represents Actor Pack component availability.
@@ -220,33 +775,578 @@ type ActorPackResponse struct {
 	TotalDownloads       int                 `json:"total_downloads"`
 	IsAvailable          bool                `json:"is_available"`
 	TrainingError        *string             `json:"training_error,omitempty"`
-	CreatedAt            *time.Time          `json:"created_at,omitempty"`
+	CurrentVersion       int                 `json:"current_version"`
+	IsArchived           bool                `json:"is_archived"`
+	AvailableFormats     []ModelFormat       `json:"available_formats,omitempty"`
+	TrainingJobID        *string             `json:"training_job_id,omitempty"` // see Client.Jobs
+	CreatedAt            *Time               `json:"created_at,omitempty"`
+}
+
+// ActorPackVersion represents a trained model version of an Actor Pack.
+type ActorPackVersion struct {
+	Version        int            `json:"version"`
+	PackID         string         `json:"pack_id"`
+	TrainingStatus TrainingStatus `json:"training_status"`
+	IsCurrent      bool           `json:"is_current"`
+	SHA256         string         `json:"sha256,omitempty"` // digest of the model weights
+	SizeBytes      int64          `json:"size_bytes,omitempty"`
+	Notes          *string        `json:"notes,omitempty"`
+	CreatedAt      *Time          `json:"created_at,omitempty"`
+}
+
+// ActorPackDownload represents a signed download URL for Actor Pack weights.
+type ActorPackDownload struct {
+	URL       string      `json:"url"`
+	Version   int         `json:"version"`
+	Format    ModelFormat `json:"format"`
+	SHA256    string      `json:"sha256,omitempty"`
+	SizeBytes int64       `json:"size_bytes,omitempty"`
+	ExpiresAt *Time       `json:"expires_at,omitempty"`
+}
+
+// DownloadEntitlement represents whether the caller may download an Actor Pack.
+type DownloadEntitlement struct {
+	Allowed   bool                    `json:"allowed"`
+	Reason    EntitlementDenialReason `json:"reason,omitempty"` // set when not allowed
+	Message   string                  `json:"message,omitempty"`
+	LicenseID *string                 `json:"license_id,omitempty"` // license granting access
+	ExpiresAt *Time                   `json:"expires_at,omitempty"`
+}
+
+// InferenceToken represents a short-lived signed token authorizing a worker to
+// load an Actor Pack under a license.
+type InferenceToken struct {
+	Token     string `json:"token"`
+	PackID    string `json:"pack_id"`
+	LicenseID string `json:"license_id"`
+	Version   int    `json:"version"` // model version the token is bound to
+	ExpiresAt *Time  `json:"expires_at,omitempty"`
+}
+
+// MotionDataUpload represents motion data attached to an Actor Pack.
+type MotionDataUpload struct {
+	ID              string         `json:"id"`
+	PackID          string         `json:"pack_id"`
+	Kind            MotionDataKind `json:"kind"`
+	FileName        string         `json:"file_name"`
+	SizeBytes       int64          `json:"size_bytes"`
+	DurationSeconds *float64       `json:"duration_seconds,omitempty"`
+	Status          string         `json:"status"`
+	CreatedAt       *Time          `json:"created_at,omitempty"`
 }
 
 // PurchaseResponse is the license purchase response.
 type PurchaseResponse struct {
 	CheckoutURL    string                 `json:"checkout_url"`
 	SessionID      string                 `json:"session_id"`
-	PriceUSD       float64                `json:"price_usd"`
+	PriceUSD       Money                  `json:"price_usd"`
 	LicenseDetails map[string]interface{} `json:"license_details"`
+	License        *LicenseResponse       `json:"license,omitempty"` // set when charged via billing profile
+}
+
+// ImageInput identifies an image by URL or base64-encoded data.
+type ImageInput struct {
+	URL    string `json:"image_url,omitempty"`
+	Base64 string `json:"image_base64,omitempty"`
+}
+
+// QuoteLineItem represents a single line in a license price breakdown.
+type QuoteLineItem struct {
+	Description string `json:"description"`
+	AmountUSD   Money  `json:"amount_usd"`
+}
+
+// LicenseQuote is the price and term breakdown for a prospective license purchase.
+type LicenseQuote struct {
+	IdentityID   string          `json:"identity_id"`
+	LicenseType  LicenseType     `json:"license_type"`
+	UsageType    UsageType       `json:"usage_type"`
+	DurationDays int             `json:"duration_days"`
+	SubtotalUSD  Money           `json:"subtotal_usd"`
+	TaxUSD       Money           `json:"tax_usd"`
+	TaxRate      float64         `json:"tax_rate"`
+	TotalUSD     Money           `json:"total_usd"`
+	Currency     string          `json:"currency"`
+	LineItems    []QuoteLineItem `json:"line_items"`
+	StartsAt     *Time           `json:"starts_at,omitempty"`
+	ExpiresAt    *Time           `json:"expires_at,omitempty"`
+	ValidUntil   *Time           `json:"valid_until,omitempty"`
+}
+
+// CheckoutSession represents the state of a license checkout session.
+type CheckoutSession struct {
+	ID          string           `json:"id"`
+	Status      CheckoutStatus   `json:"status"`
+	CheckoutURL string           `json:"checkout_url"`
+	PriceUSD    Money            `json:"price_usd"`
+	License     *LicenseResponse `json:"license,omitempty"`
+	ExpiresAt   *Time            `json:"expires_at,omitempty"`
+	CompletedAt *Time            `json:"completed_at,omitempty"`
+}
+
+// PaymentMethod represents a saved payment method.
+type PaymentMethod struct {
+	ID        string  `json:"id"`
+	Type      string  `json:"type"`
+	Brand     *string `json:"brand,omitempty"`
+	Last4     *string `json:"last4,omitempty"`
+	ExpMonth  *int    `json:"exp_month,omitempty"`
+	ExpYear   *int    `json:"exp_year,omitempty"`
+	IsDefault bool    `json:"is_default"`
+	CreatedAt *Time   `json:"created_at,omitempty"`
+}
+
+// BillingProfile represents an invoicing profile used for server-side purchases.
+type BillingProfile struct {
+	ID              string  `json:"id"`
+	Name            string  `json:"name"`
+	BillingEmail    string  `json:"billing_email"`
+	CompanyName     *string `json:"company_name,omitempty"`
+	TaxID           *string `json:"tax_id,omitempty"`
+	PaymentMethodID *string `json:"payment_method_id,omitempty"`
+	InvoiceTerms    *string `json:"invoice_terms,omitempty"`
+	IsDefault       bool    `json:"is_default"`
+	CreatedAt       *Time   `json:"created_at,omitempty"`
+}
+
+// Invoice represents a billing invoice.
+type Invoice struct {
+	ID            string `json:"id"`
+	Number        string `json:"number"`
+	Status        string `json:"status"`
+	Currency      string `json:"currency"`
+	SubtotalUSD   Money  `json:"subtotal_usd"`
+	TaxUSD        Money  `json:"tax_usd"`
+	TotalUSD      Money  `json:"total_usd"`
+	AmountPaidUSD Money  `json:"amount_paid_usd"`
+	PeriodStart   *Time  `json:"period_start,omitempty"`
+	PeriodEnd     *Time  `json:"period_end,omitempty"`
+	DueAt         *Time  `json:"due_at,omitempty"`
+	PaidAt        *Time  `json:"paid_at,omitempty"`
+	CreatedAt     *Time  `json:"created_at,omitempty"`
+}
+
+// EvidenceFile represents a file attached as supporting evidence.
+type EvidenceFile struct {
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	URL         string `json:"url,omitempty"`
+	DataBase64  string `json:"data_base64,omitempty"`
+}
+
+// Dispute represents a dispute on a verification result or takedown.
+type Dispute struct {
+	ID          string            `json:"id"`
+	TargetType  DisputeTargetType `json:"target_type"`
+	TargetID    string            `json:"target_id"`
+	Status      DisputeStatus     `json:"status"`
+	Reason      string            `json:"reason"`
+	Description *string           `json:"description,omitempty"`
+	Evidence    []EvidenceFile    `json:"evidence"`
+	Resolution  *string           `json:"resolution,omitempty"`
+	CreatedAt   *Time             `json:"created_at,omitempty"`
+	UpdatedAt   *Time             `json:"updated_at,omitempty"`
+	ResolvedAt  *Time             `json:"resolved_at,omitempty"`
+}
+
+// RevenueBreakdown represents earnings attributed to a single dimension value.
+type RevenueBreakdown struct {
+	Key          string `json:"key"`
+	AmountUSD    Money  `json:"amount_usd"`
+	LicenseCount int    `json:"license_count"`
+}
+
+// RevenueBucket represents earnings within a single time bucket.
+type RevenueBucket struct {
+	Start        Time  `json:"start"`
+	End          Time  `json:"end"`
+	AmountUSD    Money `json:"amount_usd"`
+	LicenseCount int   `json:"license_count"`
+}
+
+// RevenueReport represents an identity's earnings over a period.
+type RevenueReport struct {
+	IdentityID    string             `json:"identity_id"`
+	Currency      string             `json:"currency"`
+	TotalUSD      Money              `json:"total_usd"`
+	ByLicenseType []RevenueBreakdown `json:"by_license_type"`
+	ByPlatform    []RevenueBreakdown `json:"by_platform"`
+	Buckets       []RevenueBucket    `json:"buckets"`
+	PeriodStart   *Time              `json:"period_start,omitempty"`
+	PeriodEnd     *Time              `json:"period_end,omitempty"`
+}
+
+// PayoutAccount represents a connected account that receives creator payouts.
+type PayoutAccount struct {
+	ID             string  `json:"id"`
+	Provider       string  `json:"provider"`
+	Status         string  `json:"status"`
+	Country        string  `json:"country"`
+	Currency       string  `json:"currency"`
+	PayoutsEnabled bool    `json:"payouts_enabled"`
+	OnboardingURL  *string `json:"onboarding_url,omitempty"`
+	CreatedAt      *Time   `json:"created_at,omitempty"`
+}
+
+// Payout represents a transfer of earnings to a creator's payout account.
+type Payout struct {
+	ID          string       `json:"id"`
+	Status      PayoutStatus `json:"status"`
+	AmountUSD   Money        `json:"amount_usd"`
+	Currency    string       `json:"currency"`
+	PeriodStart *Time        `json:"period_start,omitempty"`
+	PeriodEnd   *Time        `json:"period_end,omitempty"`
+	ArrivalAt   *Time        `json:"arrival_at,omitempty"`
+	FailureCode *string      `json:"failure_code,omitempty"`
+	CreatedAt   *Time        `json:"created_at,omitempty"`
+}
+
+// PayoutSchedule represents how often earnings are paid out.
+type PayoutSchedule struct {
+	Interval         string `json:"interval"` // "daily", "weekly", or "monthly"
+	DelayDays        int    `json:"delay_days"`
+	MinimumAmountUSD Money  `json:"minimum_amount_usd"`
+	NextPayoutAt     *Time  `json:"next_payout_at,omitempty"`
+}
+
+// Transaction represents a single money movement on the account.
+type Transaction struct {
+	ID          string          `json:"id"`
+	Type        TransactionType `json:"type"`
+	Status      string          `json:"status"`
+	AmountUSD   Money           `json:"amount_usd"`
+	Currency    string          `json:"currency"`
+	Description *string         `json:"description,omitempty"`
+	LicenseID   *string         `json:"license_id,omitempty"`
+	InvoiceID   *string         `json:"invoice_id,omitempty"`
+	PayoutID    *string         `json:"payout_id,omitempty"`
+	CreatedAt   *Time           `json:"created_at,omitempty"`
+}
+
+// TransactionList is a page of transactions.
+type TransactionList struct {
+	Transactions []Transaction `json:"transactions"`
+	NextCursor   string        `json:"next_cursor,omitempty"`
+	HasMore      bool          `json:"has_more"`
+}
+
+// LicenseExtension is the result of extending an active license.
+type LicenseExtension struct {
+	License           LicenseResponse `json:"license"`
+	ExtraDays         int             `json:"extra_days"`
+	ChargeUSD         Money           `json:"charge_usd"`
+	PreviousExpiresAt *Time           `json:"previous_expires_at,omitempty"`
+	NewExpiresAt      *Time           `json:"new_expires_at,omitempty"`
+	CheckoutURL       *string         `json:"checkout_url,omitempty"` // set when payment requires checkout
+}
+
+// PurchaseLineItem represents a single license within a bulk purchase.
+type PurchaseLineItem struct {
+	IdentityID   string      `json:"identity_id"`
+	LicenseType  LicenseType `json:"license_type"`
+	UsageType    UsageType   `json:"usage_type"`
+	ProjectName  string      `json:"project_name"`
+	DurationDays int         `json:"duration_days"`
+	PriceUSD     Money       `json:"price_usd"`
+}
+
+// BulkPurchaseResponse is the response for a multi-license purchase.
+type BulkPurchaseResponse struct {
+	CheckoutURL   string             `json:"checkout_url"`
+	SessionID     string             `json:"session_id"`
+	TotalPriceUSD Money              `json:"total_price_usd"`
+	Items         []PurchaseLineItem `json:"items"`
+}
+
+// ServiceComponent reports the health of one part of the API, such as
+// "verification" or "consent".
+type ServiceComponent struct {
+	ID        string        `json:"id"`
+	Name      string        `json:"name"`
+	Status    ServiceHealth `json:"status"`
+	UpdatedAt *Time         `json:"updated_at,omitempty"`
+}
+
+// ServiceIncident describes an ongoing incident or scheduled maintenance.
+type ServiceIncident struct {
+	ID         string        `json:"id"`
+	Title      string        `json:"title"`
+	Impact     ServiceHealth `json:"impact"`
+	Status     string        `json:"status"` // e.g. "investigating", "identified", "monitoring"
+	Message    string        `json:"message"`
+	Components []string      `json:"components"` // IDs of affected components
+	URL        string        `json:"url"`
+	StartedAt  *Time         `json:"started_at,omitempty"`
+	UpdatedAt  *Time         `json:"updated_at,omitempty"`
+}
+
+// ServiceStatus reports the overall health of the API, its components, and
+// any ongoing incidents.
+type ServiceStatus struct {
+	Status     ServiceHealth      `json:"status"`
+	Components []ServiceComponent `json:"components"`
+	Incidents  []ServiceIncident  `json:"incidents"`
+	UpdatedAt  *Time              `json:"updated_at,omitempty"`
+}
+
+// IsOperational reports whether the API as a whole is fully operational.
+func (s *ServiceStatus) IsOperational() bool {
+	return s.Status == ServiceOperational
+}
+
+// ComponentHealth returns the health of the component with the given ID, or
+// the overall status if the component is not listed.
+func (s *ServiceStatus) ComponentHealth(id string) ServiceHealth {
+	for _, c := range s.Components {
+		if c.ID == id {
+			return c.Status
+		}
+	}
+	return s.Status
+}
+
+// Account represents the account and API key the client is authenticated as.
+type Account struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	Email       string        `json:"email"`
+	Plan        string        `json:"plan"`
+	Environment string        `json:"environment"` // "live" or "test"
+	Features    []string      `json:"features"`
+	KeyID       string        `json:"key_id"`
+	KeyName     string        `json:"key_name"`
+	KeyScopes   []APIKeyScope `json:"key_scopes"`
+	CreatedAt   *Time         `json:"created_at,omitempty"`
+}
+
+// APIKey represents an API key. The secret itself is never returned after creation.
+type APIKey struct {
+	ID         string        `json:"id"`
+	Name       string        `json:"name"`
+	Prefix     string        `json:"prefix"`
+	Scopes     []APIKeyScope `json:"scopes"`
+	ExpiresAt  *Time         `json:"expires_at,omitempty"`
+	LastUsedAt *Time         `json:"last_used_at,omitempty"`
+	RevokedAt  *Time         `json:"revoked_at,omitempty"`
+	CreatedAt  *Time         `json:"created_at,omitempty"`
+}
+
+// CreatedAPIKey is a newly created API key including its secret.
+type CreatedAPIKey struct {
+	APIKey
+	Key string `json:"key"`
+}
+
+// IdentityStatsPoint represents verification activity within a single time bucket.
+type IdentityStatsPoint struct {
+	Start              Time `json:"start"`
+	End                Time `json:"end"`
+	Verifications      int  `json:"verifications"`
+	Matches            int  `json:"matches"`
+	BlockedAttempts    int  `json:"blocked_attempts"`
+	LicenseConversions int  `json:"license_conversions"`
+}
+
+// IdentityStats represents verification analytics for an identity over a period.
+type IdentityStats struct {
+	IdentityID         string               `json:"identity_id"`
+	Granularity        Granularity          `json:"granularity"`
+	Verifications      int                  `json:"verifications"`
+	Matches            int                  `json:"matches"`
+	BlockedAttempts    int                  `json:"blocked_attempts"`
+	LicenseConversions int                  `json:"license_conversions"`
+	Series             []IdentityStatsPoint `json:"series"`
+}
+
+// DetectionAlert represents an unlicensed match of an identity in a third-party
+// verification call.
+type DetectionAlert struct {
+	ID                    string  `json:"id"`
+	IdentityID            string  `json:"identity_id"`
+	SourcePlatform        string  `json:"source_platform"`
+	SimilarityScore       float64 `json:"similarity_score"`
+	VerificationRequestID string  `json:"verification_request_id"`
+	ThumbnailURL          *string `json:"thumbnail_url,omitempty"` // only where permitted
+	SourceURL             *string `json:"source_url,omitempty"`
+	DetectedAt            *Time   `json:"detected_at,omitempty"`
+}
+
+// TakedownEvent represents a single entry in a takedown case timeline.
+type TakedownEvent struct {
+	Status     TakedownStatus `json:"status"`
+	Note       *string        `json:"note,omitempty"`
+	OccurredAt Time           `json:"occurred_at"`
+}
+
+// Takedown represents an enforcement case against infringing content.
+type Takedown struct {
+	ID         string          `json:"id"`
+	IdentityID string          `json:"identity_id"`
+	ContentURL string          `json:"content_url"`
+	Platform   *string         `json:"platform,omitempty"`
+	Status     TakedownStatus  `json:"status"`
+	Timeline   []TakedownEvent `json:"timeline"`
+	CreatedAt  *Time           `json:"created_at,omitempty"`
+	UpdatedAt  *Time           `json:"updated_at,omitempty"`
+}
+
+// Monitor represents a continuous web scanning subscription for an identity.
+type Monitor struct {
+	ID         string   `json:"id"`
+	IdentityID string   `json:"identity_id"`
+	Sources    []string `json:"sources"`
+	Status     string   `json:"status"`
+	LastScanAt *Time    `json:"last_scan_at,omitempty"`
+	CreatedAt  *Time    `json:"created_at,omitempty"`
+}
+
+// MonitorHit represents content found by a monitor that matches the identity.
+type MonitorHit struct {
+	ID              string  `json:"id"`
+	MonitorID       string  `json:"monitor_id"`
+	IdentityID      string  `json:"identity_id"`
+	Source          string  `json:"source"`
+	URL             string  `json:"url"`
+	ThumbnailURL    *string `json:"thumbnail_url,omitempty"`
+	SimilarityScore float64 `json:"similarity_score"`
+	Licensed        bool    `json:"licensed"`
+	DetectedAt      *Time   `json:"detected_at,omitempty"`
+}
+
+// WatermarkEmbedResponse is the response from watermark embedding.
+type WatermarkEmbedResponse struct {
+	WatermarkID string `json:"watermark_id"`
+	LicenseID   string `json:"license_id"`
+	ImageBase64 string `json:"image_base64"`
+	Format      string `json:"format"`
+}
+
+// WatermarkDetection is the response from watermark detection.
+type WatermarkDetection struct {
+	Detected    bool             `json:"detected"`
+	Confidence  float64          `json:"confidence"`
+	WatermarkID *string          `json:"watermark_id,omitempty"`
+	LicenseID   *string          `json:"license_id,omitempty"`
+	IdentityID  *string          `json:"identity_id,omitempty"`
+	License     *LicenseResponse `json:"license,omitempty"`
+}
+
+// DataDeletionRequest represents an erasure request and its progress.
+type DataDeletionRequest struct {
+	ID               string             `json:"id"`
+	Status           DataDeletionStatus `json:"status"`
+	SubjectReference string             `json:"subject_reference"`
+	Scopes           []string           `json:"scopes"`
+	RejectionReason  *string            `json:"rejection_reason,omitempty"`
+	ReceivedAt       *Time              `json:"received_at,omitempty"`
+	DueAt            *Time              `json:"due_at,omitempty"`
+	CompletedAt      *Time              `json:"completed_at,omitempty"`
+}
+
+// BiometricConsentEvidence represents recorded consent for biometric processing.
+type BiometricConsentEvidence struct {
+	ID               string `json:"id"`
+	SubjectReference string `json:"subject_reference"`
+	Jurisdiction     string `json:"jurisdiction"`
+	Purpose          string `json:"purpose"`
+	RecordedAt       *Time  `json:"recorded_at,omitempty"`
+	RetainUntil      *Time  `json:"retain_until,omitempty"`
+}
+
+// TransparencyConsentSummary summarizes consent checks in a transparency report.
+type TransparencyConsentSummary struct {
+	Total   int `json:"total"`
+	Allowed int `json:"allowed"`
+	Denied  int `json:"denied"`
+}
+
+// TransparencyLicenseEntry records a license used for synthetic content.
+type TransparencyLicenseEntry struct {
+	LicenseID        string      `json:"license_id"`
+	IdentityID       string      `json:"identity_id"`
+	LicenseType      LicenseType `json:"license_type"`
+	UsageType        UsageType   `json:"usage_type"`
+	GenerationsCount int         `json:"generations_count"`
+}
+
+// TransparencyReport is the EU AI Act transparency report for a period.
+type TransparencyReport struct {
+	AccountID                string                     `json:"account_id"`
+	PeriodStart              Time                       `json:"period_start"`
+	PeriodEnd                Time                       `json:"period_end"`
+	SyntheticGenerations     int                        `json:"synthetic_generations"`
+	GenerationsByPlatform    map[string]int             `json:"generations_by_platform"`
+	ConsentChecks            TransparencyConsentSummary `json:"consent_checks"`
+	Licenses                 []TransparencyLicenseEntry `json:"licenses"`
+	ContentCredentialsIssued int                        `json:"content_credentials_issued"`
+	GeneratedAt              *Time                      `json:"generated_at,omitempty"`
 }
 
 // VerifyRequest represents the request for identity verification.
 type VerifyRequest struct {
 	ImageURL              string `json:"image_url,omitempty"`
 	ImageBase64           string `json:"image_base64,omitempty"`
+	UploadID              string `json:"upload_id,omitempty"` // from UploadMedia, in place of the image
+	IncludeLicenseOptions bool   `json:"include_license_options,omitempty"`
+	ConsentEvidenceID     string `json:"consent_evidence_id,omitempty"` // from RecordBiometricConsent
+}
+
+// VoiceVerifyRequest represents the request for voice verification.
+type VoiceVerifyRequest struct {
+	AudioURL              string `json:"audio_url,omitempty"`
+	AudioBase64           string `json:"audio_base64,omitempty"`
+	IncludeLicenseOptions bool   `json:"include_license_options,omitempty"`
+	ConsentEvidenceID     string `json:"consent_evidence_id,omitempty"` // from RecordBiometricConsent
+}
+
+// MultiModalVerifyRequest represents the request to verify an image and an
+// audio clip together, such as a frame and the soundtrack of a generated video.
+type MultiModalVerifyRequest struct {
+	ImageURL              string `json:"image_url,omitempty"`
+	ImageBase64           string `json:"image_base64,omitempty"`
+	AudioURL              string `json:"audio_url,omitempty"`
+	AudioBase64           string `json:"audio_base64,omitempty"`
 	IncludeLicenseOptions bool   `json:"include_license_options,omitempty"`
+	ConsentEvidenceID     string `json:"consent_evidence_id,omitempty"` // from RecordBiometricConsent
+}
+
+// VideoVerifyRequest represents the request for video verification. Provide
+// one of VideoURL, Video, or UploadID; Video is uploaded as multipart form
+// data, so prefer UploadResumable for long videos on unreliable links.
+type VideoVerifyRequest struct {
+	VideoURL              string    `json:"video_url,omitempty"`
+	Video                 io.Reader `json:"-"`
+	FileName              string    `json:"-"`                    // name for the uploaded Video, e.g. "clip.mp4"
+	UploadID              string    `json:"upload_id,omitempty"`  // from UploadResumable, in place of the video
+	SampleFPS             float64   `json:"sample_fps,omitempty"` // frames sampled per second; 0 uses the server default
+	IncludeLicenseOptions bool      `json:"include_license_options,omitempty"`
+	ConsentEvidenceID     string    `json:"consent_evidence_id,omitempty"`
 }
 
 // ConsentCheckRequest represents the request for consent check.
 type ConsentCheckRequest struct {
-	ImageURL      string    `json:"image_url,omitempty"`
-	ImageBase64   string    `json:"image_base64,omitempty"`
-	FaceEmbedding []float64 `json:"face_embedding,omitempty"`
-	Platform      string    `json:"platform"`
-	IntendedUse   string    `json:"intended_use"`
-	Region        string    `json:"region,omitempty"`
-	ConsentToken  string    `json:"consent_token,omitempty"` // Optional: self-consent token from identity owner
+	ImageURL      string      `json:"image_url,omitempty"`
+	ImageBase64   string      `json:"image_base64,omitempty"`
+	FaceEmbedding []float64   `json:"face_embedding,omitempty"`
+	UploadID      string      `json:"upload_id,omitempty"` // from UploadMedia, in place of the image
+	Platform      Platform    `json:"platform"`
+	IntendedUse   IntendedUse `json:"intended_use"`
+	Region        string      `json:"region,omitempty"`
+	Regions       []string    `json:"regions,omitempty"`       // evaluate several regions at once, see ConsentResult.Regions
+	ConsentToken  string      `json:"consent_token,omitempty"` // Optional: self-consent token from identity owner
+
+	// IncludeReceipt requests a signed receipt of the consent state, see VerifyReceipt.
+	IncludeReceipt bool `json:"include_receipt,omitempty"`
+
+	// ConsentEvidenceID references biometric consent recorded with RecordBiometricConsent.
+	ConsentEvidenceID string `json:"consent_evidence_id,omitempty"`
+}
+
+// PromptScreenRequest represents the request to screen a generation prompt.
+type PromptScreenRequest struct {
+	Prompt         string   `json:"prompt"`
+	NegativePrompt string   `json:"negative_prompt,omitempty"`
+	Platform       Platform `json:"platform,omitempty"`
 }
 
 // MarketplaceListRequest represents the request for marketplace listing.
@@ -255,11 +1355,12 @@ type MarketplaceListRequest struct {
 	Category string   `json:"category,omitempty"`
 	Tags     []string `json:"tags,omitempty"`
 	Featured *bool    `json:"featured,omitempty"`
-	MinPrice *float64 `json:"min_price,omitempty"`
-	MaxPrice *float64 `json:"max_price,omitempty"`
+	MinPrice *Money   `json:"min_price,omitempty"`
+	MaxPrice *Money   `json:"max_price,omitempty"`
 	SortBy   string   `json:"sort_by,omitempty"`
 	Page     int      `json:"page,omitempty"`
 	Limit    int      `json:"limit,omitempty"`
+	Fields   []string `json:"fields,omitempty"` // return only these fields, see WithFields
 }
 
 // PurchaseLicenseRequest represents the request for license purchase.
@@ -273,4 +1374,111 @@ type PurchaseLicenseRequest struct {
 	AllowedPlatforms   []string `json:"allowed_platforms,omitempty"`
 	MaxImpressions     *int     `json:"max_impressions,omitempty"`
 	MaxOutputs         *int     `json:"max_outputs,omitempty"`
+
+	// BillingProfileID and PaymentMethodID charge the purchase directly
+	// instead of creating a checkout session.
+	BillingProfileID string `json:"billing_profile_id,omitempty"`
+	PaymentMethodID  string `json:"payment_method_id,omitempty"`
+}
+
+// AttachPaymentMethodRequest represents the request to save a payment method.
+type AttachPaymentMethodRequest struct {
+	PaymentMethodToken string `json:"payment_method_token"`
+	BillingProfileID   string `json:"billing_profile_id,omitempty"`
+	SetDefault         bool   `json:"set_default,omitempty"`
+}
+
+// CreateDisputeRequest represents the request to open a dispute.
+type CreateDisputeRequest struct {
+	TargetType  DisputeTargetType `json:"target_type"`
+	TargetID    string            `json:"target_id"` // verification request_id or takedown case ID
+	Reason      string            `json:"reason"`
+	Description string            `json:"description,omitempty"`
+	Evidence    []EvidenceFile    `json:"evidence,omitempty"`
+}
+
+// ConnectPayoutAccountRequest represents the request to connect a payout account.
+type ConnectPayoutAccountRequest struct {
+	Country   string `json:"country"`
+	Currency  string `json:"currency,omitempty"`
+	ReturnURL string `json:"return_url,omitempty"`
+}
+
+// TransactionListRequest represents the filters for listing transactions.
+type TransactionListRequest struct {
+	Types  []TransactionType
+	Since  time.Time
+	Until  time.Time
+	Cursor string
+	Limit  int
+}
+
+// CreateAPIKeyRequest represents the request to create an API key.
+type CreateAPIKeyRequest struct {
+	Name      string        `json:"name"`
+	Scopes    []APIKeyScope `json:"scopes,omitempty"`
+	ExpiresAt *time.Time    `json:"expires_at,omitempty"`
+}
+
+// TakedownRequest represents a takedown submission for infringing content.
+type TakedownRequest struct {
+	IdentityID       string         `json:"identity_id"`
+	ContentURL       string         `json:"content_url"`
+	Platform         string         `json:"platform,omitempty"`
+	Description      string         `json:"description,omitempty"`
+	DetectionAlertID string         `json:"detection_alert_id,omitempty"`
+	Evidence         []EvidenceFile `json:"evidence,omitempty"`
+}
+
+// WatermarkEmbedRequest represents the request to watermark an image.
+type WatermarkEmbedRequest struct {
+	ImageURL    string `json:"image_url,omitempty"`
+	ImageBase64 string `json:"image_base64,omitempty"`
+	LicenseID   string `json:"license_id"`
+	Format      string `json:"format,omitempty"` // output format, "png" or "jpeg"
+}
+
+// DataDeletionSubject identifies the data subject of an erasure request.
+type DataDeletionSubject struct {
+	SubjectReference string   `json:"subject_reference,omitempty"` // your own reference for the data subject
+	IdentityID       string   `json:"identity_id,omitempty"`
+	Email            string   `json:"email,omitempty"`
+	Scopes           []string `json:"scopes,omitempty"` // e.g. "embeddings", "verification_logs"; empty means all
+	Reason           string   `json:"reason,omitempty"`
+}
+
+// BiometricConsentRequest represents written consent from a data subject for
+// biometric processing.
+type BiometricConsentRequest struct {
+	SubjectReference string     `json:"subject_reference"`
+	Jurisdiction     string     `json:"jurisdiction"` // e.g. "US-IL", "US-TX"
+	Purpose          string     `json:"purpose"`
+	DisclosureText   string     `json:"disclosure_text"`
+	SignatureMethod  string     `json:"signature_method,omitempty"` // e.g. "electronic", "wet_ink"
+	SignedAt         *time.Time `json:"signed_at,omitempty"`
+	RetentionDays    int        `json:"retention_days,omitempty"`
+	DocumentBase64   string     `json:"document_base64,omitempty"` // signed release, if captured
+}
+
+// StartIdentityClaimRequest represents the request to claim an identity record.
+type StartIdentityClaimRequest struct {
+	IdentityID  string `json:"identity_id,omitempty"` // existing record to claim
+	DisplayName string `json:"display_name,omitempty"`
+	Email       string `json:"email"`
+	ReturnURL   string `json:"return_url,omitempty"` // redirect after the liveness challenge
+}
+
+// ClaimEvidence represents evidence submitted for an identity claim.
+type ClaimEvidence struct {
+	LivenessSessionID string         `json:"liveness_session_id,omitempty"`
+	SocialProfileURLs []string       `json:"social_profile_urls,omitempty"`
+	Documents         []EvidenceFile `json:"documents,omitempty"`
+	Notes             string         `json:"notes,omitempty"`
+}
+
+// ActorPackDownloadRequest represents the options for downloading Actor Pack
+// weights.
+type ActorPackDownloadRequest struct {
+	Version int         // pinned model version; 0 selects the current version
+	Format  ModelFormat // export format; empty selects the pack's default format
 }
@@ -0,0 +1,140 @@
+package actorhub
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// microsPerDollar is the resolution of Money.
+const microsPerDollar = 1_000_000
+
+// Money is an exact amount of US dollars, held as a whole number of
+// millionths of a dollar so that sums and comparisons reconcile to the cent.
+// It unmarshals from a JSON number or string, such as 19.99 or "19.99",
+// without passing through a float; digits past the sixth decimal place are
+// rounded half away from zero. It marshals as a JSON number.
+//
+// The zero value is $0. Use Float64 where a float is still wanted.
+type Money struct {
+	micros int64
+}
+
+// MoneyFromCents returns an amount of cents as Money.
+func MoneyFromCents(cents int64) Money {
+	return Money{micros: cents * (microsPerDollar / 100)}
+}
+
+// MoneyFromMicros returns an amount of millionths of a dollar as Money.
+func MoneyFromMicros(micros int64) Money {
+	return Money{micros: micros}
+}
+
+// ParseMoney parses a decimal dollar amount such as "19.99", "-0.002", or
+// "1e-5".
+func ParseMoney(s string) (Money, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return Money{}, fmt.Errorf("actorhub: invalid money amount %q", s)
+	}
+	r.Mul(r, big.NewRat(microsPerDollar, 1))
+	q, m := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if m.Abs(m).Lsh(m, 1).Cmp(r.Denom()) >= 0 {
+		q.Add(q, big.NewInt(int64(r.Sign())))
+	}
+	if !q.IsInt64() {
+		return Money{}, fmt.Errorf("actorhub: money amount %q out of range", s)
+	}
+	return Money{micros: q.Int64()}, nil
+}
+
+// Micros returns the amount in millionths of a dollar.
+func (m Money) Micros() int64 {
+	return m.micros
+}
+
+// Cents returns the amount in cents, rounded half away from zero.
+func (m Money) Cents() int64 {
+	const per = microsPerDollar / 100
+	if m.micros < 0 {
+		return (m.micros - per/2) / per
+	}
+	return (m.micros + per/2) / per
+}
+
+// Float64 returns the amount in dollars as a float, as the field held before
+// it became Money. It is inexact; do not use it for reconciliation.
+func (m Money) Float64() float64 {
+	return float64(m.micros) / microsPerDollar
+}
+
+// Add returns m + n.
+func (m Money) Add(n Money) Money {
+	return Money{micros: m.micros + n.micros}
+}
+
+// Sub returns m - n.
+func (m Money) Sub(n Money) Money {
+	return Money{micros: m.micros - n.micros}
+}
+
+// Cmp compares m and n, returning -1, 0, or +1.
+func (m Money) Cmp(n Money) int {
+	switch {
+	case m.micros < n.micros:
+		return -1
+	case m.micros > n.micros:
+		return 1
+	}
+	return 0
+}
+
+// IsZero reports whether the amount is $0.
+func (m Money) IsZero() bool {
+	return m.micros == 0
+}
+
+// String formats the amount in dollars with at least two decimal places,
+// such as "19.99" or "0.002".
+func (m Money) String() string {
+	var sign string
+	u := uint64(m.micros)
+	if m.micros < 0 {
+		sign = "-"
+		u = uint64(-m.micros) // correct for math.MinInt64 too
+	}
+	frac := fmt.Sprintf("%06d", u%microsPerDollar)
+	frac = strings.TrimRight(frac, "0")
+	for len(frac) < 2 {
+		frac += "0"
+	}
+	return sign + strconv.FormatUint(u/microsPerDollar, 10) + "." + frac
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	s := string(data)
+	if len(s) >= 2 && s[0] == '"' {
+		unquoted, err := strconv.Unquote(s)
+		if err != nil {
+			return fmt.Errorf("actorhub: invalid money amount %s", s)
+		}
+		s = unquoted
+	}
+	v, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
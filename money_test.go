@@ -0,0 +1,16 @@
+package actorhub
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIdentityTotalRevenueIsExact(t *testing.T) {
+	var identity IdentityResponse
+	if err := json.Unmarshal([]byte(`{"id":"id_1","total_revenue":1234.57}`), &identity); err != nil {
+		t.Fatal(err)
+	}
+	if got := identity.TotalRevenue.Cents(); got != 123457 {
+		t.Errorf("TotalRevenue.Cents() = %d, want 123457", got)
+	}
+}
@@ -0,0 +1,230 @@
+package actorhub
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Pager walks the pages of a list endpoint. It follows the next_cursor the
+// server returns where an endpoint supports cursors, which neither skips nor
+// repeats items while the list changes, and falls back to page numbers
+// otherwise:
+//
+//	pager := client.MarketplacePager(&actorhub.MarketplaceListRequest{Category: "ACTOR"})
+//	for pager.Next(ctx) {
+//	    for _, listing := range pager.Page() {
+//	        fmt.Println(listing.Title)
+//	    }
+//	}
+//	if err := pager.Err(); err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// A Pager is not safe for concurrent use.
+type Pager[T any] struct {
+	client *Client
+	path   string
+	params url.Values
+	limit  int
+
+	page   int
+	cursor string
+	seen   int // items fetched so far by page number
+	items  []T
+	done   bool
+	err    error
+}
+
+// newPager returns a pager over path starting at page, with params as the
+// fixed query parameters. A limit <= 0 uses the server's page size.
+func newPager[T any](c *Client, path string, params url.Values, page, limit int, err error) *Pager[T] {
+	if page <= 0 {
+		page = 1
+	}
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Del("page")
+	params.Del("limit")
+	params.Del("cursor")
+	return &Pager[T]{client: c, path: path, params: params, page: page, limit: limit, err: err}
+}
+
+// Next fetches the next page, reporting false once there are no more pages
+// or a request fails. Check Err after the loop.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	if p.done || p.err != nil {
+		return false
+	}
+
+	params := url.Values{}
+	for k, v := range p.params {
+		params[k] = v
+	}
+	if p.limit > 0 {
+		params.Set("limit", strconv.Itoa(p.limit))
+	}
+	if p.cursor != "" {
+		params.Set("cursor", p.cursor)
+	} else if p.page > 1 {
+		params.Set("page", strconv.Itoa(p.page))
+	}
+
+	var resp pageResponse[T]
+	if err := p.client.doRequest(ctx, http.MethodGet, p.path+"?"+params.Encode(), nil, &resp); err != nil {
+		p.err = err
+		p.items = nil
+		return false
+	}
+	p.items = resp.Items
+
+	switch {
+	case resp.NextCursor != "":
+		p.cursor = resp.NextCursor
+		p.done = resp.HasMore != nil && !*resp.HasMore
+	case p.cursor != "":
+		p.done = true // the cursor walk has ended
+	case resp.HasMore != nil:
+		p.page++
+		p.done = !*resp.HasMore
+	default:
+		// The server may cap pages below the limit asked for, so a short
+		// page does not mean the last one; stop at the reported total or
+		// at an empty page.
+		p.page++
+		p.seen += len(resp.Items)
+		p.done = resp.Total != nil && p.seen >= *resp.Total
+	}
+
+	if len(resp.Items) == 0 {
+		p.done = true
+		return false
+	}
+	return true
+}
+
+// Page returns the items of the page fetched by the last call to Next.
+func (p *Pager[T]) Page() []T {
+	return p.items
+}
+
+// Err returns the error that stopped the pager, if any.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// pageResponse decodes one page of a list endpoint, either a bare JSON
+// array or an object holding the items with next_cursor, has_more, or
+// total.
+type pageResponse[T any] struct {
+	Items      []T
+	NextCursor string
+	HasMore    *bool
+	Total      *int
+}
+
+// pageItemKeys are the names list endpoints use for the items of a page.
+var pageItemKeys = []string{"items", "data", "results", "transactions"}
+
+func (r *pageResponse[T]) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &r.Items); err == nil {
+		return nil
+	}
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return err
+	}
+	if raw, ok := envelope["next_cursor"]; ok {
+		json.Unmarshal(raw, &r.NextCursor)
+	}
+	if raw, ok := envelope["has_more"]; ok {
+		var hasMore bool
+		if json.Unmarshal(raw, &hasMore) == nil {
+			r.HasMore = &hasMore
+		}
+	}
+	if raw, ok := envelope["total"]; ok {
+		var total int
+		if json.Unmarshal(raw, &total) == nil {
+			r.Total = &total
+		}
+	}
+	for _, key := range pageItemKeys {
+		if raw, ok := envelope[key]; ok {
+			return json.Unmarshal(raw, &r.Items)
+		}
+	}
+	return nil
+}
+
+// MarketplacePager returns a Pager over marketplace listings matching req,
+// starting at req.Page with req.Limit listings per page.
+func (c *Client) MarketplacePager(req *MarketplaceListRequest) *Pager[MarketplaceListingResponse] {
+	params, err := marketplaceParams(req)
+	page, limit := 0, 0
+	if req != nil {
+		page, limit = req.Page, req.Limit
+	}
+	return newPager[MarketplaceListingResponse](c, "/api/v1/marketplace/listings", params, page, limit, err)
+}
+
+// MyLicensesPager returns a Pager over licenses purchased by the current
+// user, filtered by status if it is not empty.
+func (c *Client) MyLicensesPager(status string) *Pager[LicenseResponse] {
+	return newPager[LicenseResponse](c, "/api/v1/marketplace/licenses/mine", statusParams(status), 0, 0, nil)
+}
+
+// TakedownsPager returns a Pager over takedown cases submitted by the
+// current account, filtered by status if it is not empty.
+func (c *Client) TakedownsPager(status TakedownStatus) *Pager[Takedown] {
+	return newPager[Takedown](c, "/api/v1/takedowns", statusParams(string(status)), 0, 0, nil)
+}
+
+// MonitorHitsPager returns a Pager over content found by a monitor.
+func (c *Client) MonitorHitsPager(monitorID string) *Pager[MonitorHit] {
+	return newPager[MonitorHit](c, "/api/v1/monitors/"+monitorID+"/hits", nil, 0, 0, nil)
+}
+
+// InvoicesPager returns a Pager over billing invoices, filtered by status if
+// it is not empty.
+func (c *Client) InvoicesPager(status string) *Pager[Invoice] {
+	return newPager[Invoice](c, "/api/v1/billing/invoices", statusParams(status), 0, 0, nil)
+}
+
+// DisputesPager returns a Pager over disputes, filtered by status if it is
+// not empty.
+func (c *Client) DisputesPager(status DisputeStatus) *Pager[Dispute] {
+	return newPager[Dispute](c, "/api/v1/disputes", statusParams(string(status)), 0, 0, nil)
+}
+
+// PayoutsPager returns a Pager over payouts, filtered by status if it is not
+// empty.
+func (c *Client) PayoutsPager(status PayoutStatus) *Pager[Payout] {
+	return newPager[Payout](c, "/api/v1/payouts", statusParams(string(status)), 0, 0, nil)
+}
+
+// TransactionsPager returns a Pager over transactions matching req, starting
+// from req.Cursor if it is set.
+func (c *Client) TransactionsPager(req *TransactionListRequest) *Pager[Transaction] {
+	params, err := transactionParams(req)
+	limit := 0
+	if req != nil {
+		limit = req.Limit
+	}
+	p := newPager[Transaction](c, "/api/v1/billing/transactions", params, 0, limit, err)
+	if req != nil {
+		p.cursor = req.Cursor
+	}
+	return p
+}
+
+func statusParams(status string) url.Values {
+	params := url.Values{}
+	if status != "" {
+		params.Set("status", status)
+	}
+	return params
+}
@@ -0,0 +1,55 @@
+package actorhub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestPagerIgnoresServerPageCap(t *testing.T) {
+	const total, serverCap = 25, 10
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		var items []string
+		for i := (page - 1) * serverCap; i < page*serverCap && i < total; i++ {
+			items = append(items, fmt.Sprintf(`{"id":"tk_%d"}`, i))
+		}
+		fmt.Fprintf(w, `{"items":[%s],"total":%d}`, strings.Join(items, ","), total)
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", WithBaseURL(srv.URL))
+	pager := c.TakedownsPager("")
+	got, requests := 0, 0
+	for pager.Next(context.Background()) {
+		got += len(pager.Page())
+		requests++
+	}
+	if err := pager.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if got != total || requests != 3 {
+		t.Errorf("got %d items in %d pages, want %d in 3", got, requests, total)
+	}
+}
+
+func TestValidateLeavesOpenSetsToServer(t *testing.T) {
+	reqs := []interface{ Validate() error }{
+		&PurchaseLicenseRequest{IdentityID: "id_1", LicenseType: "future_type", UsageType: "future_use"},
+		&MarketplaceListRequest{Limit: 500},
+		&WatermarkEmbedRequest{ImageURL: "https://example.com/a.jpg", LicenseID: "lic_1", Format: "webp"},
+		&ConsentCheckRequest{FaceEmbedding: make([]float64, 1024), Platform: PlatformRunway, IntendedUse: IntendedUseVideo},
+	}
+	for _, req := range reqs {
+		if err := req.Validate(); err != nil {
+			t.Errorf("%T: %v", req, err)
+		}
+	}
+}
@@ -0,0 +1,315 @@
+// Package pipeline bundles the checks an image or video generation pipeline
+// runs before generating: prompt screening with Client.ScreenPrompt, consent
+// checks on reference images, and selecting the licenses to attach to the
+// output.
+//
+// It is designed to be called from Go inference servers or from the backend
+// of ComfyUI custom nodes, once per generation:
+//
+//	p := pipeline.New(client, pipeline.WithLicenseRequired())
+//	result, err := p.PreGenerationHook(ctx, &pipeline.Inputs{
+//	    Prompt:          prompt,
+//	    ReferenceImages: []actorhub.ImageInput{{URL: refURL}},
+//	    Params:          policy.Params{Platform: "comfyui", Commercial: true},
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	if !result.Decision.Allowed {
+//	    return fmt.Errorf("generation denied: %s", result.Decision.Reasons[0].Message)
+//	}
+//
+//	// After generating, record the license in the output.
+//	if len(result.Licenses) > 0 {
+//	    output, err = c2pa.AttachCredentials(output, result.Licenses[0],
+//	        c2pa.WithSigner(signer),
+//	        c2pa.WithConsentCheckID(result.ConsentCheckIDs[0]),
+//	    )
+//	}
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	actorhub "github.com/actorhubai/actorhub-go"
+	"github.com/actorhubai/actorhub-go/policy"
+)
+
+// Inputs describes a proposed generation.
+type Inputs struct {
+	Prompt          string
+	NegativePrompt  string
+	ReferenceImages []actorhub.ImageInput
+	Params          policy.Params
+	ConsentToken    string // self-consent token from the identity owner, if any
+}
+
+// Result is the outcome of the pre-generation checks.
+type Result struct {
+	Decision policy.Decision
+
+	// ConsentCheckIDs holds the request ID of each reference image's consent
+	// check, in order, for recording with c2pa.WithConsentCheckID. It has
+	// one entry per reference image; the entry is empty for an image whose
+	// check did not complete, such as a potential minor or one decided by
+	// the failure policy.
+	ConsentCheckIDs []string
+
+	// Licenses holds an active license for each protected identity that has
+	// one, to attach to the output with c2pa.AttachCredentials or
+	// watermark.Embed.
+	Licenses []actorhub.LicenseResponse
+
+	// Unlicensed holds the IDs of protected identities without a license.
+	Unlicensed []string
+}
+
+// PromptScreen screens a generation's prompts, for example with
+// Client.ScreenPrompt or your own moderation service.
+type PromptScreen func(ctx context.Context, prompt, negativePrompt string) (policy.Decision, error)
+
+// ScreenPrompt returns a PromptScreen backed by Client.ScreenPrompt, the
+// default for a Pipeline. A prompt is denied when ActorHub flags it, with a
+// reason for each protected identity it names. If the API is unavailable,
+// the client's failure policy decides and the decision is marked as
+// degraded.
+func ScreenPrompt(client *actorhub.Client) PromptScreen {
+	return func(ctx context.Context, prompt, negativePrompt string) (policy.Decision, error) {
+		res, err := client.ScreenPrompt(ctx, &actorhub.PromptScreenRequest{
+			Prompt:         prompt,
+			NegativePrompt: negativePrompt,
+		})
+		if actorhub.IsUnavailable(err) {
+			return policy.DegradedDecision(client.FailurePolicy(), err), nil
+		}
+		if err != nil {
+			return policy.Decision{}, err
+		}
+		if !res.Flagged {
+			return policy.Decision{Allowed: true}, nil
+		}
+
+		var d policy.Decision
+		for _, identity := range res.Identities {
+			if !identity.Protected {
+				continue
+			}
+			name := identity.IdentityID
+			if identity.DisplayName != nil {
+				name = *identity.DisplayName
+			}
+			d.Reasons = append(d.Reasons, policy.Reason{
+				IdentityID: identity.IdentityID,
+				Code:       policy.ReasonPromptFlagged,
+				Message:    name + ": named in the prompt as " + strconv.Quote(identity.MatchedText),
+			})
+		}
+		if len(d.Reasons) == 0 {
+			message := "prompt was flagged"
+			if len(res.Categories) > 0 {
+				message += " for " + strings.Join(res.Categories, ", ")
+			}
+			d.Reasons = []policy.Reason{{Code: policy.ReasonPromptFlagged, Message: message}}
+		}
+		return d, nil
+	}
+}
+
+// Pipeline runs pre-generation checks with an ActorHub client.
+type Pipeline struct {
+	client          *actorhub.Client
+	promptScreen    PromptScreen
+	licenseRequired bool
+}
+
+// Option configures a Pipeline.
+type Option func(*Pipeline)
+
+// WithPromptScreen replaces the default ScreenPrompt screen, which runs
+// before reference images are checked, for example with your own moderation
+// service. A denied prompt skips the consent checks. Pass nil to skip
+// prompt screening.
+func WithPromptScreen(screen PromptScreen) Option {
+	return func(p *Pipeline) {
+		p.promptScreen = screen
+	}
+}
+
+// WithLicenseRequired denies generations that use a protected identity for
+// which no active license covers the platform.
+func WithLicenseRequired() Option {
+	return func(p *Pipeline) {
+		p.licenseRequired = true
+	}
+}
+
+// New creates a Pipeline that screens prompts with ScreenPrompt.
+func New(client *actorhub.Client, opts ...Option) *Pipeline {
+	p := &Pipeline{client: client, promptScreen: ScreenPrompt(client)}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// PreGenerationHook screens the prompt, checks consent for every reference
+// image, and selects the licenses covering the protected identities found.
+// An error is returned only when a check could not be run; denials are
+// reported in Result.Decision. If the API is unavailable, the client's
+// failure policy decides and the decision is marked as degraded; Licenses
+// and Unlicensed may then be incomplete.
+func (p *Pipeline) PreGenerationHook(ctx context.Context, in *Inputs) (*Result, error) {
+	result := &Result{Decision: policy.Decision{Allowed: true}}
+
+	if p.promptScreen != nil && (in.Prompt != "" || in.NegativePrompt != "") {
+		d, err := p.promptScreen(ctx, in.Prompt, in.NegativePrompt)
+		if err != nil {
+			return nil, err
+		}
+		if !d.Allowed {
+			result.Decision = d
+			return result, nil
+		}
+		if d.Degraded {
+			degrade(result, d)
+		}
+	}
+
+	intendedUse := actorhub.IntendedUseImage
+	if in.Params.Video {
+		intendedUse = actorhub.IntendedUseVideo
+	}
+
+	var protected []string
+	for _, image := range in.ReferenceImages {
+		resp, err := p.client.CheckConsent(ctx, &actorhub.ConsentCheckRequest{
+			ImageURL:     image.URL,
+			ImageBase64:  image.Base64,
+			Platform:     actorhub.Platform(in.Params.Platform),
+			IntendedUse:  intendedUse,
+			Region:       in.Params.Region,
+			ConsentToken: in.ConsentToken,
+		})
+		var minorErr *actorhub.MinorDetectedError
+		if errors.As(err, &minorErr) {
+			result.ConsentCheckIDs = append(result.ConsentCheckIDs, "")
+			deny(result, policy.Decision{Reasons: []policy.Reason{{
+				Code:    policy.ReasonPotentialMinor,
+				Message: "face may belong to a minor",
+			}}})
+			continue
+		}
+		if actorhub.IsUnavailable(err) {
+			result.ConsentCheckIDs = append(result.ConsentCheckIDs, "")
+			degrade(result, policy.DegradedDecision(p.client.FailurePolicy(), err))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result.ConsentCheckIDs = append(result.ConsentCheckIDs, resp.RequestID)
+		deny(result, policy.Evaluate(resp, in.Params))
+		if resp.Stale {
+			result.Decision.Degraded = true
+		}
+		for _, face := range resp.Faces {
+			if face.Protected && face.IdentityID != nil {
+				protected = appendUnique(protected, *face.IdentityID)
+			}
+		}
+	}
+
+	if len(protected) == 0 {
+		return result, nil
+	}
+
+	licenses, err := p.client.ListAllLicenses(ctx, "active")
+	if actorhub.IsUnavailable(err) {
+		d := policy.DegradedDecision(p.client.FailurePolicy(), err)
+		if !p.licenseRequired {
+			d = policy.Decision{Allowed: true, Degraded: true, Reasons: d.Reasons}
+		}
+		degrade(result, d)
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for _, identityID := range protected {
+		license, ok := findLicense(licenses, identityID, in.Params.Platform, now)
+		if ok {
+			result.Licenses = append(result.Licenses, license)
+			continue
+		}
+		result.Unlicensed = append(result.Unlicensed, identityID)
+		if p.licenseRequired {
+			deny(result, policy.Decision{Reasons: []policy.Reason{{
+				IdentityID: identityID,
+				Code:       policy.ReasonLicenseRequired,
+				Message:    identityID + ": no active license covers this platform",
+			}}})
+		}
+	}
+
+	return result, nil
+}
+
+// deny merges a denial into the result's decision.
+func deny(result *Result, d policy.Decision) {
+	if !d.Allowed {
+		result.Decision.Allowed = false
+		result.Decision.Reasons = append(result.Decision.Reasons, d.Reasons...)
+	}
+}
+
+// degrade merges a decision made by the failure policy into the result's
+// decision.
+func degrade(result *Result, d policy.Decision) {
+	result.Decision.Degraded = true
+	if !d.Allowed {
+		deny(result, d)
+		return
+	}
+	result.Decision.Reasons = append(result.Decision.Reasons, d.Reasons...)
+}
+
+// findLicense returns an unexpired license for the identity covering the platform.
+func findLicense(licenses []actorhub.LicenseResponse, identityID, platform string, now time.Time) (actorhub.LicenseResponse, bool) {
+	for _, license := range licenses {
+		if license.IdentityID != identityID {
+			continue
+		}
+		if license.ExpiresAt != nil && now.After(license.ExpiresAt.Time) {
+			continue
+		}
+		if len(license.AllowedPlatforms) > 0 && platform != "" && !containsFold(license.AllowedPlatforms, platform) {
+			continue
+		}
+		return license, true
+	}
+	return actorhub.LicenseResponse{}, false
+}
+
+func containsFold(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func appendUnique(list []string, value string) []string {
+	for _, item := range list {
+		if item == value {
+			return list
+		}
+	}
+	return append(list, value)
+}
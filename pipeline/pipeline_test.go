@@ -0,0 +1,116 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	actorhub "github.com/actorhubai/actorhub-go"
+	"github.com/actorhubai/actorhub-go/policy"
+)
+
+func TestPreGenerationHookFindsLicenseBeyondFirstPage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/consent/check":
+			w.Write([]byte(`{"request_id":"req_1","protected":true,"faces_detected":1,
+				"faces":[{"protected":true,"identity_id":"id_target","consent":{"commercial":true}}]}`))
+		case "/api/v1/marketplace/licenses/mine":
+			var licenses []string
+			switch r.URL.Query().Get("page") {
+			case "":
+				for i := 0; i < 100; i++ {
+					licenses = append(licenses, fmt.Sprintf(`{"id":"lic_%d","identity_id":"id_%d"}`, i, i))
+				}
+			case "2":
+				licenses = append(licenses, `{"id":"lic_target","identity_id":"id_target"}`)
+			}
+			w.Write([]byte("[" + strings.Join(licenses, ",") + "]"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	client := actorhub.NewClient("key", actorhub.WithBaseURL(srv.URL))
+	p := New(client, WithLicenseRequired())
+	result, err := p.PreGenerationHook(context.Background(), &Inputs{
+		ReferenceImages: []actorhub.ImageInput{{URL: "https://example.com/a.jpg"}},
+		Params:          policy.Params{Platform: "runway"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Unlicensed) != 0 {
+		t.Errorf("Unlicensed = %v, want none", result.Unlicensed)
+	}
+	if len(result.Licenses) != 1 || result.Licenses[0].ID != "lic_target" {
+		t.Errorf("Licenses = %+v, want lic_target", result.Licenses)
+	}
+}
+
+func TestPreGenerationHookScreensPromptByDefault(t *testing.T) {
+	var consentChecks int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/consent/screen-prompt":
+			w.Write([]byte(`{"flagged":true,"categories":[],"identities":[
+				{"identity_id":"id_1","display_name":"Jane Star","matched_text":"jane star","protected":true}]}`))
+		default:
+			consentChecks++
+			w.Write([]byte(`{"request_id":"req_1","protected":false,"faces_detected":0,"faces":[]}`))
+		}
+	}))
+	defer srv.Close()
+
+	client := actorhub.NewClient("key", actorhub.WithBaseURL(srv.URL), actorhub.WithMaxRetries(1))
+	result, err := New(client).PreGenerationHook(context.Background(), &Inputs{
+		Prompt:          "jane star on a beach",
+		ReferenceImages: []actorhub.ImageInput{{URL: "https://example.com/a.jpg"}},
+		Params:          policy.Params{Platform: "runway"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := result.Decision
+	if d.Allowed || len(d.Reasons) != 1 || d.Reasons[0].Code != policy.ReasonPromptFlagged || d.Reasons[0].IdentityID != "id_1" {
+		t.Errorf("Decision = %+v, want a prompt_flagged denial for id_1", d)
+	}
+	if consentChecks != 0 {
+		t.Errorf("ran %d consent checks after the prompt was denied", consentChecks)
+	}
+}
+
+func TestConsentCheckIDsAlignWithImages(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req actorhub.ConsentCheckRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.ImageURL == "https://example.com/down.jpg" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"request_id":"req_` + strings.TrimSuffix(strings.TrimPrefix(req.ImageURL, "https://example.com/"), ".jpg") + `","protected":false,"faces_detected":0,"faces":[]}`))
+	}))
+	defer srv.Close()
+
+	client := actorhub.NewClient("key", actorhub.WithBaseURL(srv.URL), actorhub.WithMaxRetries(1))
+	result, err := New(client, WithPromptScreen(nil)).PreGenerationHook(context.Background(), &Inputs{
+		ReferenceImages: []actorhub.ImageInput{
+			{URL: "https://example.com/a.jpg"},
+			{URL: "https://example.com/down.jpg"},
+			{URL: "https://example.com/c.jpg"},
+		},
+		Params: policy.Params{Platform: "runway"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"req_a", "", "req_c"}
+	if strings.Join(result.ConsentCheckIDs, ",") != strings.Join(want, ",") {
+		t.Errorf("ConsentCheckIDs = %q, want %q", result.ConsentCheckIDs, want)
+	}
+}
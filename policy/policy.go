@@ -0,0 +1,200 @@
+// Package policy evaluates consent check results locally against the
+// parameters of a proposed generation.
+//
+// It applies the consent flags and blocked categories, regions, and brands
+// returned by Client.CheckConsent so every integration makes the same
+// allow/deny decision:
+//
+//	result, err := client.CheckConsent(ctx, req)
+//	if err != nil {
+//	    return err
+//	}
+//	decision := policy.Evaluate(result, policy.Params{
+//	    Platform:   "runway",
+//	    Category:   "advertising",
+//	    Region:     "US",
+//	    Commercial: true,
+//	    Video:      true,
+//	})
+//	if !decision.Allowed {
+//	    for _, r := range decision.Reasons {
+//	        fmt.Println(r.Message)
+//	    }
+//	}
+package policy
+
+import (
+	"fmt"
+	"strings"
+
+	actorhub "github.com/actorhubai/actorhub-go"
+)
+
+// Params describes the proposed generation.
+type Params struct {
+	Platform string
+	Category string // free text or an actorhub.ContentCategory value
+	Region   string
+	Brand    string
+
+	Commercial bool // output is used commercially
+	Video      bool // output is a video
+	AITraining bool // input is used to train a model
+	Deepfake   bool // output replaces or manipulates a real person's likeness
+}
+
+// ReasonCode identifies why a generation was denied.
+type ReasonCode string
+
+const (
+	ReasonCommercialNotAllowed ReasonCode = "commercial_not_allowed"
+	ReasonVideoNotAllowed      ReasonCode = "video_not_allowed"
+	ReasonAITrainingNotAllowed ReasonCode = "ai_training_not_allowed"
+	ReasonDeepfakeNotAllowed   ReasonCode = "deepfake_not_allowed"
+	ReasonCategoryBlocked      ReasonCode = "category_blocked"
+	ReasonRegionBlocked        ReasonCode = "region_blocked"
+	ReasonBrandBlocked         ReasonCode = "brand_blocked"
+	ReasonPotentialMinor       ReasonCode = "potential_minor"
+	ReasonLicenseRequired      ReasonCode = "license_required"
+	ReasonServiceUnavailable   ReasonCode = "service_unavailable"
+	ReasonUnreadableRequest    ReasonCode = "unreadable_request"
+	ReasonPromptFlagged        ReasonCode = "prompt_flagged"
+)
+
+// Reason explains a denial for a single identity.
+type Reason struct {
+	IdentityID string
+	Code       ReasonCode
+	Message    string
+}
+
+// Decision is the outcome of evaluating a generation.
+type Decision struct {
+	Allowed bool
+	Reasons []Reason
+
+	// Degraded is set when consent could not be checked because the API was
+	// unavailable, so the decision was made by the client's failure policy or
+	// from a stale cached consent check.
+	Degraded bool
+}
+
+// DegradedDecision returns the decision prescribed by a failure policy when
+// consent could not be checked because of err. It is marked as degraded and
+// carries a ReasonServiceUnavailable reason, even when it allows the
+// generation, so the fallback can be logged.
+func DegradedDecision(fp actorhub.FailurePolicy, err error) Decision {
+	return Decision{
+		Allowed:  fp == actorhub.FailOpen,
+		Degraded: true,
+		Reasons: []Reason{{
+			Code:    ReasonServiceUnavailable,
+			Message: "consent could not be checked: " + err.Error(),
+		}},
+	}
+}
+
+// Evaluate decides whether a generation is allowed for every face in a
+// consent check response. Faces that are not protected are allowed unless
+// they may belong to a minor.
+func Evaluate(resp *actorhub.ConsentCheckResponse, p Params) Decision {
+	decision := Decision{Allowed: true}
+	for _, face := range resp.Faces {
+		d := EvaluateFace(face, p)
+		if !d.Allowed {
+			decision.Allowed = false
+			decision.Reasons = append(decision.Reasons, d.Reasons...)
+		}
+	}
+	return decision
+}
+
+// EvaluateFace decides whether a generation is allowed for a single face.
+// Faces that may belong to a minor are always denied. A valid self-consent
+// token listing the platform overrides the identity's default consent
+// flags, but not the categories, regions, and brands the owner blocked.
+func EvaluateFace(face actorhub.ConsentResult, p Params) Decision {
+	identityID := ""
+	if face.IdentityID != nil {
+		identityID = *face.IdentityID
+	}
+
+	if face.PotentialMinor() {
+		return Decision{Reasons: []Reason{{
+			IdentityID: identityID,
+			Code:       ReasonPotentialMinor,
+			Message:    "face may belong to a minor",
+		}}}
+	}
+	if !face.Protected {
+		return Decision{Allowed: true}
+	}
+
+	name := identityID
+	if name == "" {
+		name = "protected identity"
+	}
+	if face.DisplayName != nil {
+		name = *face.DisplayName
+	}
+
+	var reasons []Reason
+	deny := func(code ReasonCode, format string, args ...interface{}) {
+		reasons = append(reasons, Reason{
+			IdentityID: identityID,
+			Code:       code,
+			Message:    name + ": " + fmt.Sprintf(format, args...),
+		})
+	}
+
+	consent := face.Consent
+	if tokenCovers(face.Token, p.Platform) {
+		consent = actorhub.ConsentDetails{CommercialUse: true, AITraining: true, VideoGeneration: true, Deepfake: true}
+	}
+	if p.Commercial && !consent.CommercialUse {
+		deny(ReasonCommercialNotAllowed, "commercial use is not permitted")
+	}
+	if p.Video && !consent.VideoGeneration {
+		deny(ReasonVideoNotAllowed, "video generation is not permitted")
+	}
+	if p.AITraining && !consent.AITraining {
+		deny(ReasonAITrainingNotAllowed, "AI training is not permitted")
+	}
+	if p.Deepfake && !consent.Deepfake {
+		deny(ReasonDeepfakeNotAllowed, "deepfakes are not permitted")
+	}
+	if p.Category != "" && face.Restrictions.BlocksCategory(p.Category) {
+		deny(ReasonCategoryBlocked, "category %q is blocked", p.Category)
+	}
+	if p.Region != "" {
+		rc, ok := face.RegionDecision(p.Region)
+		if contains(face.Restrictions.BlockedRegions, p.Region) || (ok && !rc.Allowed) {
+			deny(ReasonRegionBlocked, "region %q is blocked", p.Region)
+		}
+	}
+	if p.Brand != "" && contains(face.Restrictions.BlockedBrands, p.Brand) {
+		deny(ReasonBrandBlocked, "brand %q is blocked", p.Brand)
+	}
+
+	return Decision{Allowed: len(reasons) == 0, Reasons: reasons}
+}
+
+// tokenCovers reports whether a valid consent token lists the platform. A
+// token without allowed platforms covers none.
+func tokenCovers(token *actorhub.ConsentTokenResult, platform string) bool {
+	if token == nil || !token.Valid || platform == "" {
+		return false
+	}
+	return contains(token.AllowedPlatforms, platform)
+}
+
+// contains reports whether list holds value, ignoring case and surrounding space.
+func contains(list []string, value string) bool {
+	value = strings.TrimSpace(value)
+	for _, item := range list {
+		if strings.EqualFold(strings.TrimSpace(item), value) {
+			return true
+		}
+	}
+	return false
+}
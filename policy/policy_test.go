@@ -0,0 +1,64 @@
+package policy
+
+import (
+	"testing"
+
+	actorhub "github.com/actorhubai/actorhub-go"
+)
+
+func tokenFace() actorhub.ConsentResult {
+	id := "id_1"
+	return actorhub.ConsentResult{
+		Protected:  true,
+		IdentityID: &id,
+		Restrictions: actorhub.ConsentRestrictions{
+			BlockedRegions: []string{"DE"},
+			BlockedBrands:  []string{"Acme"},
+		},
+		Token: &actorhub.ConsentTokenResult{Valid: true, AllowedPlatforms: []string{"runway"}},
+	}
+}
+
+func TestTokenCoversConsentFlags(t *testing.T) {
+	d := EvaluateFace(tokenFace(), Params{Platform: "runway", Region: "US", Commercial: true, Video: true})
+	if !d.Allowed {
+		t.Errorf("token on its platform denied: %v", d.Reasons)
+	}
+
+	d = EvaluateFace(tokenFace(), Params{Platform: "pika", Commercial: true})
+	if d.Allowed {
+		t.Error("token allowed a platform it does not list")
+	}
+}
+
+func TestTokenDoesNotOverrideRestrictions(t *testing.T) {
+	tests := []struct {
+		name   string
+		params Params
+		want   ReasonCode
+	}{
+		{"blocked region", Params{Platform: "runway", Region: "de"}, ReasonRegionBlocked},
+		{"blocked brand", Params{Platform: "runway", Brand: "acme"}, ReasonBrandBlocked},
+	}
+	for _, tt := range tests {
+		d := EvaluateFace(tokenFace(), tt.params)
+		if d.Allowed || len(d.Reasons) != 1 || d.Reasons[0].Code != tt.want {
+			t.Errorf("%s: got Allowed=%v Reasons=%v, want a %s denial", tt.name, d.Allowed, d.Reasons, tt.want)
+		}
+	}
+
+	face := tokenFace()
+	face.Restrictions = actorhub.ConsentRestrictions{}
+	face.Regions = []actorhub.RegionConsent{{Region: "FR", Allowed: false}}
+	if d := EvaluateFace(face, Params{Platform: "runway", Region: "FR"}); d.Allowed {
+		t.Error("token overrode a region decision")
+	}
+}
+
+func TestTokenWithoutPlatforms(t *testing.T) {
+	face := tokenFace()
+	face.Token.AllowedPlatforms = nil
+	if d := EvaluateFace(face, Params{Platform: "runway", Commercial: true}); d.Allowed {
+		t.Error("token without allowed platforms covered runway")
+	}
+}
@@ -0,0 +1,81 @@
+package actorhub
+
+// Ptr returns a pointer to v, for setting optional request fields:
+//
+//	req := &actorhub.MarketplaceListRequest{Featured: actorhub.Ptr(true)}
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// ValueOr returns the value p points to, or fallback if p is nil:
+//
+//	name := actorhub.ValueOr(result.DisplayName, "unknown")
+func ValueOr[T any](p *T, fallback T) T {
+	if p == nil {
+		return fallback
+	}
+	return *p
+}
+
+// Value returns the value p points to, or the zero value if p is nil.
+func Value[T any](p *T) T {
+	var zero T
+	return ValueOr(p, zero)
+}
+
+// GetIdentityID returns the matched identity ID, or "" if there is none.
+func (r VerifyResult) GetIdentityID() string { return Value(r.IdentityID) }
+
+// GetDisplayName returns the identity's display name, or "" if there is none.
+func (r VerifyResult) GetDisplayName() string { return Value(r.DisplayName) }
+
+// GetSimilarityScore returns the similarity score, or 0 if there is none.
+func (r VerifyResult) GetSimilarityScore() float64 { return Value(r.SimilarityScore) }
+
+// GetIdentityID returns the matched identity ID, or "" if there is none.
+func (r ConsentResult) GetIdentityID() string { return Value(r.IdentityID) }
+
+// GetDisplayName returns the identity's display name, or "" if there is none.
+func (r ConsentResult) GetDisplayName() string { return Value(r.DisplayName) }
+
+// GetSimilarityScore returns the similarity score, or 0 if there is none.
+func (r ConsentResult) GetSimilarityScore() float64 { return Value(r.SimilarityScore) }
+
+// GetIdentityID returns the matched identity ID, or "" if there is none.
+func (r VoiceVerifyResult) GetIdentityID() string { return Value(r.IdentityID) }
+
+// GetDisplayName returns the identity's display name, or "" if there is none.
+func (r VoiceVerifyResult) GetDisplayName() string { return Value(r.DisplayName) }
+
+// GetSimilarityScore returns the similarity score, or 0 if there is none.
+func (r VoiceVerifyResult) GetSimilarityScore() float64 { return Value(r.SimilarityScore) }
+
+// GetDisplayName returns the identity's display name, or "" if there is none.
+func (m VoiceMatch) GetDisplayName() string { return Value(m.DisplayName) }
+
+// GetDisplayName returns the identity's display name, or "" if there is none.
+func (m MultiModalMatch) GetDisplayName() string { return Value(m.DisplayName) }
+
+// GetFaceConfidence returns the face match confidence, or 0 if the face did
+// not match.
+func (m MultiModalMatch) GetFaceConfidence() float64 { return Value(m.FaceConfidence) }
+
+// GetVoiceConfidence returns the voice match confidence, or 0 if the voice
+// did not match.
+func (m MultiModalMatch) GetVoiceConfidence() float64 { return Value(m.VoiceConfidence) }
+
+// GetDescription returns the listing description, or "" if there is none.
+func (l MarketplaceListingResponse) GetDescription() string { return Value(l.Description) }
+
+// GetProfileImageURL returns the profile image URL, or "" if there is none.
+func (l MarketplaceListingResponse) GetProfileImageURL() string { return Value(l.ProfileImageURL) }
+
+// GetRating returns the listing rating, or 0 if it has not been rated.
+func (l MarketplaceListingResponse) GetRating() float64 { return Value(l.Rating) }
+
+// GetProfileImageURL returns the profile image URL, or "" if there is none.
+func (s SimilarIdentity) GetProfileImageURL() string { return Value(s.ProfileImageURL) }
+
+// GetListingID returns the marketplace listing ID, or "" if the identity is
+// not listed.
+func (s SimilarIdentity) GetListingID() string { return Value(s.ListingID) }
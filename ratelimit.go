@@ -0,0 +1,69 @@
+package actorhub
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimitState describes the rate-limit headroom reported by the API.
+type RateLimitState struct {
+	// Limit is the maximum number of requests allowed in the current window.
+	Limit int
+	// Remaining is the number of requests left in the current window.
+	Remaining int
+	// Reset is when the current window resets.
+	Reset time.Time
+	// ObservedAt is when the state was read from a response. It is zero if no
+	// response has carried rate-limit headers yet.
+	ObservedAt time.Time
+}
+
+// parseRateLimitState reads the X-RateLimit-* headers from a response.
+// It reports false if the response carries no rate-limit headers.
+func parseRateLimitState(header http.Header, now time.Time) (RateLimitState, bool) {
+	limit := header.Get("X-RateLimit-Limit")
+	remaining := header.Get("X-RateLimit-Remaining")
+	reset := header.Get("X-RateLimit-Reset")
+	if limit == "" && remaining == "" && reset == "" {
+		return RateLimitState{}, false
+	}
+
+	state := RateLimitState{ObservedAt: now}
+	state.Limit, _ = strconv.Atoi(limit)
+	state.Remaining, _ = strconv.Atoi(remaining)
+	if r, err := strconv.ParseInt(reset, 10, 64); err == nil {
+		// Large values are Unix timestamps, small ones are seconds from now.
+		if r > 1_000_000_000 {
+			state.Reset = time.Unix(r, 0)
+		} else {
+			state.Reset = now.Add(time.Duration(r) * time.Second)
+		}
+	}
+	return state, true
+}
+
+// rateLimitTracker holds the most recently observed rate-limit state.
+type rateLimitTracker struct {
+	mu    sync.Mutex
+	state RateLimitState
+}
+
+func (t *rateLimitTracker) update(state RateLimitState) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.state = state
+}
+
+func (t *rateLimitTracker) get() RateLimitState {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.state
+}
+
+// RateLimitState returns the rate-limit state from the most recent response
+// that carried X-RateLimit-* headers.
+func (c *Client) RateLimitState() RateLimitState {
+	return c.rateLimit.get()
+}
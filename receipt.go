@@ -0,0 +1,139 @@
+package actorhub
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ErrInvalidReceipt is returned when a consent receipt fails validation.
+var ErrInvalidReceipt = errors.New("actorhub: invalid consent receipt")
+
+// ConsentReceiptFace records the consent state of a single face at check time.
+type ConsentReceiptFace struct {
+	IdentityID *string        `json:"identity_id,omitempty"`
+	Protected  bool           `json:"protected"`
+	Consent    ConsentDetails `json:"consent"`
+}
+
+// ConsentReceipt is the verified content of a signed consent receipt.
+type ConsentReceipt struct {
+	RequestID   string               `json:"request_id"`
+	Issuer      string               `json:"iss"`
+	IssuedAt    time.Time            `json:"-"`
+	Platform    string               `json:"platform"`
+	IntendedUse string               `json:"intended_use"`
+	Region      string               `json:"region,omitempty"`
+	Protected   bool                 `json:"protected"`
+	Faces       []ConsentReceiptFace `json:"faces"`
+	KeyID       string               `json:"-"`
+}
+
+// receiptHeader is the JOSE header of a consent receipt.
+type receiptHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// splitReceipt splits a consent receipt JWT into its segments and decodes
+// its header.
+func splitReceipt(token string) ([]string, *receiptHeader, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, nil, fmt.Errorf("%w: malformed token", ErrInvalidReceipt)
+	}
+	var header receiptHeader
+	if err := decodeJWTSegment(parts[0], &header); err != nil {
+		return nil, nil, err
+	}
+	return parts, &header, nil
+}
+
+// ReceiptKeyID returns the ID of the key that signed a consent receipt, for
+// looking the key up with PublicKey. The receipt is not verified.
+func ReceiptKeyID(token string) (string, error) {
+	_, header, err := splitReceipt(token)
+	if err != nil {
+		return "", err
+	}
+	if header.Kid == "" {
+		return "", fmt.Errorf("%w: missing key ID", ErrInvalidReceipt)
+	}
+	return header.Kid, nil
+}
+
+// VerifyReceipt validates a consent receipt against the ActorHub signing key
+// named in it, fetched through the client's key cache, and returns the
+// recorded consent state. See the VerifyReceipt function for offline use.
+func (c *Client) VerifyReceipt(ctx context.Context, token string) (*ConsentReceipt, error) {
+	keyID, err := ReceiptKeyID(token)
+	if err != nil {
+		return nil, err
+	}
+	key, err := c.PublicKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	return VerifyReceipt(token, key)
+}
+
+// VerifyReceipt validates a consent receipt JWT offline against ActorHub's
+// ES256 public key and returns the recorded consent state. Receipts are
+// evidence of a past check and do not expire.
+func VerifyReceipt(token string, publicKey crypto.PublicKey) (*ConsentReceipt, error) {
+	parts, header, err := splitReceipt(token)
+	if err != nil {
+		return nil, err
+	}
+	if header.Alg != "ES256" {
+		return nil, fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidReceipt, header.Alg)
+	}
+
+	key, ok := publicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: public key must be ECDSA", ErrInvalidReceipt)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || len(sig) != 64 {
+		return nil, fmt.Errorf("%w: malformed signature", ErrInvalidReceipt)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	if !ecdsa.Verify(key, digest[:], r, s) {
+		return nil, fmt.Errorf("%w: signature mismatch", ErrInvalidReceipt)
+	}
+
+	var claims struct {
+		ConsentReceipt
+		IssuedAt int64 `json:"iat"`
+	}
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+
+	receipt := claims.ConsentReceipt
+	receipt.IssuedAt = time.Unix(claims.IssuedAt, 0).UTC()
+	receipt.KeyID = header.Kid
+	return &receipt, nil
+}
+
+// decodeJWTSegment decodes a base64url JSON segment of a JWT.
+func decodeJWTSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return fmt.Errorf("%w: malformed token", ErrInvalidReceipt)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%w: malformed token", ErrInvalidReceipt)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package actorhub
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// signReceipt returns a consent receipt JWT with the given claims, signed
+// with key under key ID kid.
+func signReceipt(t *testing.T, key *ecdsa.PrivateKey, kid, claims string) string {
+	t.Helper()
+	signingInput := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"ES256","kid":"`+kid+`"}`)) +
+		"." + base64.RawURLEncoding.EncodeToString([]byte(claims))
+	digest := sha256.Sum256([]byte(signingInput))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestClientVerifyReceipt(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"keys":[{"kty":"EC","crv":"P-256","kid":"k1","x":"%s","y":"%s"}]}`,
+			base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+			base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))))
+	}))
+	defer srv.Close()
+	c := NewClient("key", WithBaseURL(srv.URL), WithMaxRetries(1))
+
+	token := signReceipt(t, key, "k1", `{"request_id":"req_1","iat":1700000000}`)
+	if id, err := ReceiptKeyID(token); err != nil || id != "k1" {
+		t.Errorf("ReceiptKeyID = %q, %v, want k1", id, err)
+	}
+	receipt, err := c.VerifyReceipt(context.Background(), token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt.RequestID != "req_1" || receipt.KeyID != "k1" {
+		t.Errorf("receipt = %+v, want request req_1 signed by k1", receipt)
+	}
+
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged := signReceipt(t, other, "k1", `{"request_id":"req_1","iat":1700000000}`)
+	if _, err := c.VerifyReceipt(context.Background(), forged); !errors.Is(err, ErrInvalidReceipt) {
+		t.Errorf("err = %v, want ErrInvalidReceipt", err)
+	}
+}
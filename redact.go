@@ -0,0 +1,69 @@
+package actorhub
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Redactor scrubs sensitive data, such as image payloads, face embeddings,
+// and credentials, from text the SDK places in errors and logs.
+type Redactor interface {
+	Redact(s string) string
+}
+
+// RedactorFunc adapts an ordinary function to the Redactor interface.
+type RedactorFunc func(s string) string
+
+// Redact calls f(s).
+func (f RedactorFunc) Redact(s string) string {
+	return f(s)
+}
+
+// DefaultRedactor removes sensitive JSON fields, long base64 runs, and
+// embedding-sized number arrays. It is always applied by the client.
+var DefaultRedactor Redactor = RedactorFunc(defaultRedact)
+
+var (
+	// sensitiveFieldPattern matches JSON string or array values of fields that
+	// carry biometric data or credentials.
+	sensitiveFieldPattern = regexp.MustCompile(
+		`"((?:image|audio|video|document|data)_base64|face_embedding|embedding|api_key|key|secret|password|token|consent_token|receipt_jwt)"\s*:\s*("(?:[^"\\]|\\.)*"|\[[^\]]*\])`)
+
+	// base64Pattern matches base64 or base64url runs long enough to be media.
+	base64Pattern = regexp.MustCompile(`(?:data:[\w/+.-]+;base64,)?[A-Za-z0-9+/_-]{200,}={0,2}`)
+
+	// embeddingPattern matches number arrays long enough to be embeddings.
+	embeddingPattern = regexp.MustCompile(`\[\s*-?\d+(?:\.\d+)?(?:[eE][-+]?\d+)?(?:\s*,\s*-?\d+(?:\.\d+)?(?:[eE][-+]?\d+)?){31,}\s*\]`)
+)
+
+func defaultRedact(s string) string {
+	s = sensitiveFieldPattern.ReplaceAllString(s, `"$1":"[REDACTED]"`)
+	s = base64Pattern.ReplaceAllStringFunc(s, func(m string) string {
+		return fmt.Sprintf("[REDACTED base64 %d chars]", len(m))
+	})
+	s = embeddingPattern.ReplaceAllString(s, `"[REDACTED embedding]"`)
+	return s
+}
+
+// WithRedactor adds a redactor applied after DefaultRedactor, for custom
+// rules such as internal identifiers.
+func WithRedactor(r Redactor) ClientOption {
+	return func(c *Client) {
+		c.redactors = append(c.redactors, r)
+	}
+}
+
+// Redact applies the client's redaction rules to s, including removal of the
+// client's API key. Use it before logging request or response data or
+// recording fixtures.
+func (c *Client) Redact(s string) string {
+	s = DefaultRedactor.Redact(s)
+	for _, r := range c.redactors {
+		s = r.Redact(s)
+	}
+	if len(c.apiKey) >= 8 {
+		s = strings.ReplaceAll(s, c.apiKey, "[REDACTED]")
+	}
+	return s
+}
@@ -0,0 +1,85 @@
+package actorhub
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+)
+
+// clientRequestIDHeader carries the client-generated ID of each call.
+const clientRequestIDHeader = "X-Client-Request-ID"
+
+type clientRequestIDKey struct{}
+
+// WithClientRequestID returns a context that makes client calls send id as
+// their X-Client-Request-ID header instead of a generated one. Use it to
+// thread your own trace or correlation ID through to ActorHub.
+func WithClientRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, clientRequestIDKey{}, id)
+}
+
+// ClientRequestID returns the client request ID set on ctx, if any.
+func ClientRequestID(ctx context.Context) string {
+	id, _ := ctx.Value(clientRequestIDKey{}).(string)
+	return id
+}
+
+// RequestError is returned when a call fails without an API error response,
+// for example because the connection failed or the response could not be
+// decoded. It records the call's client request ID for correlation.
+type RequestError struct {
+	ClientRequestID string
+	Err             error
+}
+
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("%v [Client Request ID: %s]", e.Err, e.ClientRequestID)
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// apiError is implemented by every error type embedding ActorHubError.
+type apiError interface {
+	apiError() *ActorHubError
+}
+
+func (e *ActorHubError) apiError() *ActorHubError {
+	return e
+}
+
+// ensureClientRequestID returns ctx with a client request ID, generating one
+// if the caller did not set it. Retries and hedged duplicates share the ID.
+func ensureClientRequestID(ctx context.Context) (context.Context, string) {
+	if id := ClientRequestID(ctx); id != "" {
+		return ctx, id
+	}
+	id := newClientRequestID()
+	return WithClientRequestID(ctx, id), id
+}
+
+// newClientRequestID returns a random UUID.
+func newClientRequestID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40 // version 4
+	b[8] = b[8]&0x3f | 0x80 // RFC 4122 variant
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// attachClientRequestID records a call's client request ID on its error.
+// Context cancellation errors are returned unchanged so they still compare
+// equal to ctx.Err().
+func attachClientRequestID(ctx context.Context, err error, id string) error {
+	if err == nil || err == ctx.Err() {
+		return err
+	}
+	var e apiError
+	if errors.As(err, &e) {
+		e.apiError().ClientRequestID = id
+		return err
+	}
+	return &RequestError{ClientRequestID: id, Err: err}
+}
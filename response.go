@@ -0,0 +1,113 @@
+package actorhub
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// ResponseMetadata holds transport-level details of an API response.
+type ResponseMetadata struct {
+	StatusCode      int
+	RequestID       string
+	ClientRequestID string
+	RateLimit       RateLimitState
+}
+
+type responseMetadataKey struct{}
+
+// WithResponseMetadata returns a context that captures the metadata of the
+// response into md once a client call using the context completes.
+//
+//	var md actorhub.ResponseMetadata
+//	result, err := client.Verify(actorhub.WithResponseMetadata(ctx, &md), req)
+//	fmt.Println(md.RateLimit.Remaining)
+func WithResponseMetadata(ctx context.Context, md *ResponseMetadata) context.Context {
+	return context.WithValue(ctx, responseMetadataKey{}, md)
+}
+
+// APIResponse is the HTTP response behind a client call, for reading headers
+// and bodies the typed result does not expose.
+type APIResponse struct {
+	StatusCode int
+	Header     http.Header
+
+	// Body is the response body after decompression. Error bodies are
+	// redacted like error messages, and bodies streamed to a writer by
+	// download methods are not kept.
+	Body []byte
+}
+
+type apiResponseKey struct{}
+
+// WithAPIResponse returns a context that captures the HTTP response into
+// resp once a client call using the context completes. Like
+// WithResponseMetadata it works with every method; when a call is retried
+// or hedged, resp holds the response that decided the outcome.
+//
+//	var resp actorhub.APIResponse
+//	identity, err := client.GetIdentity(actorhub.WithAPIResponse(ctx, &resp), id)
+//	fmt.Println(resp.Header.Get("ETag"))
+func WithAPIResponse(ctx context.Context, resp *APIResponse) context.Context {
+	return context.WithValue(ctx, apiResponseKey{}, resp)
+}
+
+// captureAPIResponse records resp and its body for WithAPIResponse.
+func captureAPIResponse(ctx context.Context, resp *http.Response, body []byte) {
+	if dst, ok := ctx.Value(apiResponseKey{}).(*APIResponse); ok && dst != nil {
+		*dst = APIResponse{StatusCode: resp.StatusCode, Header: resp.Header, Body: body}
+	}
+}
+
+// VerifyWithResponse is like Verify but also returns the HTTP response, or
+// nil if none was received.
+func (c *Client) VerifyWithResponse(ctx context.Context, req *VerifyRequest) (*VerifyResponse, *APIResponse, error) {
+	var resp APIResponse
+	result, err := c.Verify(WithAPIResponse(ctx, &resp), req)
+	return result, resp.orNil(), err
+}
+
+// CheckConsentWithResponse is like CheckConsent but also returns the HTTP
+// response, or nil if none was received. A stale result served by
+// WithStaleConsentFallback comes with the failed response.
+func (c *Client) CheckConsentWithResponse(ctx context.Context, req *ConsentCheckRequest) (*ConsentCheckResponse, *APIResponse, error) {
+	var resp APIResponse
+	result, err := c.CheckConsent(WithAPIResponse(ctx, &resp), req)
+	return result, resp.orNil(), err
+}
+
+func (r *APIResponse) orNil() *APIResponse {
+	if r.StatusCode == 0 {
+		return nil
+	}
+	return r
+}
+
+// newResponseMetadata extracts metadata from an HTTP response.
+func newResponseMetadata(resp *http.Response) ResponseMetadata {
+	rateLimit, _ := parseRateLimitState(resp.Header, time.Now())
+	md := ResponseMetadata{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get("X-Request-ID"),
+		RateLimit:  rateLimit,
+	}
+	if resp.Request != nil {
+		md.ClientRequestID = resp.Request.Header.Get(clientRequestIDHeader)
+	}
+	return md
+}
+
+// recordResponse updates the client's rate-limit state and any metadata
+// requested through the context.
+func (c *Client) recordResponse(ctx context.Context, resp *http.Response) {
+	md := newResponseMetadata(resp)
+	if !md.RateLimit.ObservedAt.IsZero() {
+		c.rateLimit.update(md.RateLimit)
+	}
+	if call := callStatsFrom(ctx); call != nil {
+		call.statusCode.Store(int32(resp.StatusCode))
+	}
+	if dst, ok := ctx.Value(responseMetadataKey{}).(*ResponseMetadata); ok && dst != nil {
+		*dst = md
+	}
+}
@@ -0,0 +1,300 @@
+package actorhub
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// tusVersion is the version of the tus resumable upload protocol spoken
+	// by UploadResumable.
+	tusVersion = "1.0.0"
+
+	// DefaultChunkSize is the chunk size UploadResumable uses when
+	// ResumableUploadOptions.ChunkSize is not set.
+	DefaultChunkSize = 8 << 20
+)
+
+// ResumableUploadOptions configures UploadResumable.
+type ResumableUploadOptions struct {
+	// ContentType and FileName describe the media to the server.
+	ContentType string
+	FileName    string
+
+	// ChunkSize is the number of bytes sent per request, DefaultChunkSize if
+	// zero. Smaller chunks lose less progress on a flaky link.
+	ChunkSize int64
+
+	// ResumeURL continues an upload started earlier, such as by a process
+	// that was interrupted, from the last chunk the server confirmed.
+	ResumeURL string
+
+	// OnCreate, if set, is called with the upload's URL once it is created.
+	// Persist it and pass it back as ResumeURL to resume after a restart.
+	OnCreate func(uploadURL string)
+
+	// OnProgress, if set, is called after each confirmed chunk.
+	OnProgress func(uploaded, total int64)
+}
+
+// UploadResumable uploads size bytes read from r with the tus resumable
+// upload protocol, for videos and other media too large to send in one
+// request. Data is sent in chunks; when a chunk fails, the client asks the
+// server how much it received and continues from there, retrying with
+// backoff up to the client's retry limit without progress. The returned
+// upload's UploadID can be referenced wherever an upload_id is accepted,
+// such as VideoVerifyRequest. Chunks cannot be encrypted, so UploadResumable
+// fails with ErrEncryptionUnsupported while WithPayloadEncryption is enabled.
+func (c *Client) UploadResumable(ctx context.Context, r io.ReaderAt, size int64, opts *ResumableUploadOptions) (*Upload, error) {
+	if c.encryption != nil {
+		return nil, ErrEncryptionUnsupported
+	}
+	ctx, clientRequestID := ensureClientRequestID(ctx)
+	upload, err := c.uploadResumable(ctx, r, size, opts)
+	if err != nil {
+		return nil, attachClientRequestID(ctx, err, clientRequestID)
+	}
+	return upload, nil
+}
+
+func (c *Client) uploadResumable(ctx context.Context, r io.ReaderAt, size int64, opts *ResumableUploadOptions) (*Upload, error) {
+	if opts == nil {
+		opts = &ResumableUploadOptions{}
+	}
+	if size <= 0 {
+		return nil, NewValidationError("size must be positive", nil, "")
+	}
+	chunkSize := opts.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+
+	uploadURL := opts.ResumeURL
+	offset := int64(0)
+	if uploadURL == "" {
+		var err error
+		uploadURL, err = c.createResumableUpload(ctx, size, opts)
+		if err != nil {
+			return nil, err
+		}
+		if opts.OnCreate != nil {
+			opts.OnCreate(uploadURL)
+		}
+	} else {
+		var err error
+		offset, err = c.resumableOffset(ctx, uploadURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	upload := &Upload{URL: uploadURL}
+	buf := make([]byte, chunkSize)
+	failures := 0
+	for offset < size {
+		n := chunkSize
+		if size-offset < n {
+			n = size - offset
+		}
+		if _, err := r.ReadAt(buf[:n], offset); err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("failed to read upload: %w", err)
+		}
+
+		next, expires, err := c.patchResumableUpload(ctx, uploadURL, offset, buf[:n])
+		if err == nil && next == offset {
+			// An acknowledged chunk that stored nothing is retried like a
+			// failed one, so a stuck server cannot keep the loop spinning.
+			err = fmt.Errorf("actorhub: resumable upload did not advance past offset %d", offset)
+		}
+		if err == nil {
+			offset, failures = next, 0
+			if !expires.IsZero() {
+				upload.ExpiresAt = Time{expires}
+			}
+			if opts.OnProgress != nil {
+				opts.OnProgress(offset, size)
+			}
+			continue
+		}
+		if ctx.Err() != nil || !retryableUploadError(err) {
+			return nil, err
+		}
+
+		failures++
+		if failures >= c.maxRetries {
+			return nil, err
+		}
+		wait, ok := retryWait(ctx, failures, err)
+		if !ok {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+
+		// The chunk may have landed in part; continue from what the
+		// server confirms rather than from what was sent.
+		if confirmed, headErr := c.resumableOffset(ctx, uploadURL); headErr == nil {
+			offset = confirmed
+		}
+	}
+
+	upload.UploadID = uploadIDFromURL(uploadURL)
+	return upload, nil
+}
+
+// createResumableUpload creates a tus upload and returns its URL.
+func (c *Client) createResumableUpload(ctx context.Context, size int64, opts *ResumableUploadOptions) (string, error) {
+	req, err := c.newTusRequest(ctx, http.MethodPost, c.baseURL+"/api/v1/uploads/resumable", nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Upload-Length", strconv.FormatInt(size, 10))
+	var metadata []string
+	if opts.ContentType != "" {
+		metadata = append(metadata, "content_type "+base64.StdEncoding.EncodeToString([]byte(opts.ContentType)))
+	}
+	if opts.FileName != "" {
+		metadata = append(metadata, "filename "+base64.StdEncoding.EncodeToString([]byte(opts.FileName)))
+	}
+	if len(metadata) > 0 {
+		req.Header.Set("Upload-Metadata", strings.Join(metadata, ","))
+	}
+
+	resp, err := c.doTusRequest(req)
+	if err != nil {
+		return "", err
+	}
+	location, err := req.URL.Parse(resp.Header.Get("Location"))
+	if err != nil || resp.Header.Get("Location") == "" {
+		return "", errors.New("actorhub: resumable upload created without a Location")
+	}
+	return location.String(), nil
+}
+
+// resumableOffset asks the server how many bytes of an upload it holds.
+func (c *Client) resumableOffset(ctx context.Context, uploadURL string) (int64, error) {
+	req, err := c.newTusRequest(ctx, http.MethodHead, uploadURL, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := c.doTusRequest(req)
+	if err != nil {
+		return 0, err
+	}
+	offset, err := strconv.ParseInt(resp.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("actorhub: invalid Upload-Offset %q", resp.Header.Get("Upload-Offset"))
+	}
+	return offset, nil
+}
+
+// patchResumableUpload sends one chunk at offset and returns the offset the
+// server confirms, with the upload's expiry if the server reports one.
+func (c *Client) patchResumableUpload(ctx context.Context, uploadURL string, offset int64, chunk []byte) (int64, time.Time, error) {
+	req, err := c.newTusRequest(ctx, http.MethodPatch, uploadURL, chunk)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	req.Header.Set("Content-Type", "application/offset+octet-stream")
+	req.Header.Set("Upload-Offset", strconv.FormatInt(offset, 10))
+
+	resp, err := c.doTusRequest(req)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	next, err := strconv.ParseInt(resp.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil || next < offset {
+		return 0, time.Time{}, fmt.Errorf("actorhub: invalid Upload-Offset %q", resp.Header.Get("Upload-Offset"))
+	}
+	expires, _ := http.ParseTime(resp.Header.Get("Upload-Expires"))
+	return next, expires, nil
+}
+
+// newTusRequest creates a tus request. Upload URLs come from the server's
+// Location header or the caller's ResumeURL, so credentials and the request
+// signature are only attached when the URL is on the client's base URL.
+func (c *Client) newTusRequest(ctx context.Context, method, uploadURL string, body []byte) (*http.Request, error) {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, uploadURL, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Tus-Resumable", tusVersion)
+	req.Header.Set("User-Agent", c.userAgent)
+	if c.sameOrigin(req.URL) {
+		req.Header.Set("X-API-Key", c.apiKey)
+		if id := ClientRequestID(ctx); id != "" {
+			req.Header.Set(clientRequestIDHeader, id)
+		}
+		c.signRequest(req, body)
+	}
+	return req, nil
+}
+
+// sameOrigin reports whether u has the scheme and host of the client's base
+// URL.
+func (c *Client) sameOrigin(u *url.URL) bool {
+	base, err := url.Parse(c.baseURL)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Scheme, base.Scheme) && strings.EqualFold(u.Host, base.Host)
+}
+
+// doTusRequest sends a tus request, mapping error statuses to the SDK's
+// error types with their bodies redacted. The response body is drained and
+// closed.
+func (c *Client) doTusRequest(req *http.Request) (*http.Response, error) {
+	resp, err := c.doHTTP(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode >= 400 {
+		respBody = []byte(c.Redact(string(respBody)))
+		return nil, responseError(resp.StatusCode, resp.Header, respBody, resp.Header.Get("X-Request-ID"))
+	}
+	return resp, nil
+}
+
+// retryableUploadError reports whether a failed chunk is worth resending:
+// after a network failure, a server error, rate limiting, or a 409, which
+// means the offset sent disagrees with the server's and rereading it
+// resolves.
+func retryableUploadError(err error) bool {
+	var e apiError
+	if !errors.As(err, &e) {
+		return true
+	}
+	var serverErr *ServerError
+	var rateLimitErr *RateLimitError
+	var conflictErr *ConflictError
+	return errors.As(err, &serverErr) || errors.As(err, &rateLimitErr) || errors.As(err, &conflictErr)
+}
+
+// uploadIDFromURL returns the upload ID, the last path segment of a
+// resumable upload's URL.
+func uploadIDFromURL(uploadURL string) string {
+	u, err := url.Parse(uploadURL)
+	if err != nil {
+		return ""
+	}
+	return path.Base(strings.TrimSuffix(u.Path, "/"))
+}
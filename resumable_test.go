@@ -0,0 +1,169 @@
+package actorhub
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// tusServer is a minimal tus server holding one upload. If failAt is
+// positive, the first PATCH crossing that offset stores the chunk only up to
+// it and fails, as a dropped connection would.
+type tusServer struct {
+	location string // returned from creation; defaults to the server's own
+	failAt   int
+
+	mu       sync.Mutex
+	data     []byte
+	keys     []string // X-API-Key of each request
+	signed   []bool
+	requests int
+}
+
+func (s *tusServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	s.keys = append(s.keys, r.Header.Get("X-API-Key"))
+	s.signed = append(s.signed, r.Header.Get(requestSignatureHeader) != "")
+	switch r.Method {
+	case http.MethodPost:
+		location := s.location
+		if location == "" {
+			location = "/api/v1/uploads/resumable/up_1"
+		}
+		w.Header().Set("Location", location)
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodHead:
+		w.Header().Set("Upload-Offset", strconv.Itoa(len(s.data)))
+	case http.MethodPatch:
+		offset, _ := strconv.Atoi(r.Header.Get("Upload-Offset"))
+		if offset != len(s.data) {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		chunk, _ := io.ReadAll(r.Body)
+		if s.failAt > 0 && offset < s.failAt && offset+len(chunk) > s.failAt {
+			s.data = append(s.data, chunk[:s.failAt-offset]...)
+			s.failAt = 0
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		s.data = append(s.data, chunk...)
+		w.Header().Set("Upload-Offset", strconv.Itoa(len(s.data)))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestUploadResumableResumesFromConfirmedOffset(t *testing.T) {
+	tus := &tusServer{failAt: 150}
+	srv := httptest.NewServer(tus)
+	defer srv.Close()
+
+	media := bytes.Repeat([]byte("0123456789"), 40)
+	c := NewClient("key_12345678", WithBaseURL(srv.URL), WithMaxRetries(3), WithRequestSigning("secret"))
+	upload, err := c.UploadResumable(context.Background(), bytes.NewReader(media), int64(len(media)),
+		&ResumableUploadOptions{ChunkSize: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(tus.data, media) {
+		t.Errorf("server holds %d bytes that differ from the %d sent", len(tus.data), len(media))
+	}
+	if upload.UploadID != "up_1" {
+		t.Errorf("UploadID = %q, want up_1", upload.UploadID)
+	}
+	for i, key := range tus.keys {
+		if key != "key_12345678" || !tus.signed[i] {
+			t.Errorf("request %d: X-API-Key = %q, signed = %v; want both on the API's origin", i, key, tus.signed[i])
+		}
+	}
+}
+
+func TestUploadResumableKeepsCredentialsOnOrigin(t *testing.T) {
+	foreign := &tusServer{}
+	foreignSrv := httptest.NewServer(foreign)
+	defer foreignSrv.Close()
+
+	media := []byte("video bytes")
+	check := func(name string) {
+		t.Helper()
+		for i, key := range foreign.keys {
+			if key != "" || foreign.signed[i] {
+				t.Errorf("%s: request %d to another origin had X-API-Key %q, signed = %v", name, i, key, foreign.signed[i])
+			}
+		}
+		if foreign.requests == 0 {
+			t.Errorf("%s: upload never reached the foreign URL", name)
+		}
+	}
+
+	// A Location pointing elsewhere, such as a storage host.
+	api := &tusServer{location: foreignSrv.URL + "/files/up_1"}
+	apiSrv := httptest.NewServer(api)
+	defer apiSrv.Close()
+	c := NewClient("key_12345678", WithBaseURL(apiSrv.URL), WithMaxRetries(1), WithRequestSigning("secret"))
+	if _, err := c.UploadResumable(context.Background(), bytes.NewReader(media), int64(len(media)), nil); err != nil {
+		t.Fatal(err)
+	}
+	if api.keys[0] != "key_12345678" {
+		t.Errorf("creation request had X-API-Key %q", api.keys[0])
+	}
+	check("Location")
+
+	// A ResumeURL elsewhere.
+	foreign.data, foreign.keys, foreign.signed, foreign.requests = nil, nil, nil, 0
+	_, err := c.UploadResumable(context.Background(), bytes.NewReader(media), int64(len(media)),
+		&ResumableUploadOptions{ResumeURL: foreignSrv.URL + "/files/up_1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("ResumeURL")
+}
+
+func TestUploadResumableRedactsErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"detail":"upload rejected for key_12345678"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("key_12345678", WithBaseURL(srv.URL), WithMaxRetries(1))
+	_, err := c.UploadResumable(context.Background(), strings.NewReader("x"), 1, nil)
+	if err == nil || strings.Contains(err.Error(), "key_12345678") {
+		t.Errorf("err = %v, want an error without the API key", err)
+	}
+}
+
+func TestUploadResumableStopsWhenOffsetDoesNotAdvance(t *testing.T) {
+	var patches int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.Header().Set("Location", "/api/v1/uploads/resumable/up_1")
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodPatch:
+			patches++
+			fallthrough
+		default:
+			w.Header().Set("Upload-Offset", "0")
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", WithBaseURL(srv.URL), WithMaxRetries(2))
+	_, err := c.UploadResumable(context.Background(), strings.NewReader("video bytes"), 11, nil)
+	if err == nil {
+		t.Fatal("upload succeeded against a server that stores nothing")
+	}
+	if patches != 2 {
+		t.Errorf("sent %d chunks, want 2 (the retry limit)", patches)
+	}
+}
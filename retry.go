@@ -0,0 +1,134 @@
+package actorhub
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultRetryBudgetTokens is the number of retries a client may make in
+	// a burst before successful requests must earn more.
+	defaultRetryBudgetTokens = 10
+
+	// defaultRetryBudgetRatio is the fraction of a retry earned back by each
+	// successful request.
+	defaultRetryBudgetRatio = 0.1
+
+	// maxRetryAfter is the longest Retry-After the client waits out itself.
+	// A longer one is returned to the caller as a RateLimitError to
+	// schedule.
+	maxRetryAfter = time.Minute
+)
+
+// WithMaxElapsedTime caps the total time a request may spend across all
+// attempts, including backoff waits. Once another wait would exceed it, the
+// last error is returned. A zero duration means no cap.
+func WithMaxElapsedTime(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.maxElapsedTime = d
+	}
+}
+
+// WithRetryBudget limits retries across all requests made by the client, so
+// retries cannot multiply load during an outage. The client may retry up to
+// tokens times in a burst; each retry spends a token and each successful
+// request earns back ratio of one. Clients have a budget of 10 tokens with a
+// ratio of 0.1 by default; pass tokens <= 0 to disable the budget.
+func WithRetryBudget(tokens int, ratio float64) ClientOption {
+	return func(c *Client) {
+		if tokens <= 0 {
+			c.retryBudget = nil
+			return
+		}
+		c.retryBudget = newRetryBudget(float64(tokens), ratio)
+	}
+}
+
+// parseRetryAfter parses a Retry-After header in either of its forms,
+// delta-seconds or an HTTP date, returning zero if it is missing, invalid,
+// or in the past.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if secs, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if secs <= 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+	return 0
+}
+
+// retryWait returns how long to back off before retrying after the given
+// number of consecutive failures ending in err: exponentially up to 10
+// seconds, or as long as the server asked with Retry-After. It reports
+// false if the wait exceeds maxRetryAfter or would outlast ctx's deadline,
+// in which case err should be returned instead.
+func retryWait(ctx context.Context, failures int, err error) (time.Duration, bool) {
+	wait := time.Duration(1<<(failures-1)) * time.Second
+	if wait > 10*time.Second {
+		wait = 10 * time.Second
+	}
+	// Waiting less than the server asked would only be refused again.
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) && rateLimitErr.RetryAfter > 0 {
+		wait = rateLimitErr.RetryAfterDuration()
+		if wait > maxRetryAfter {
+			return 0, false
+		}
+	}
+	// Don't start a wait that cannot finish in time.
+	if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+		return 0, false
+	}
+	return wait, true
+}
+
+// retryBudget is a token bucket shared by all requests from a client.
+type retryBudget struct {
+	mu     sync.Mutex
+	tokens float64
+	max    float64
+	ratio  float64
+}
+
+func newRetryBudget(max, ratio float64) *retryBudget {
+	return &retryBudget{tokens: max, max: max, ratio: ratio}
+}
+
+// spend takes a token for a retry, reporting false if none are left.
+func (b *retryBudget) spend() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// earn credits a successful request.
+func (b *retryBudget) earn() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens += b.ratio
+	if b.tokens > b.max {
+		b.tokens = b.max
+	}
+}
@@ -0,0 +1,50 @@
+package actorhub
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLongRetryAfterIsReturned(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", WithBaseURL(srv.URL), WithMaxRetries(3))
+	start := time.Now()
+	_, err := c.Verify(context.Background(), &VerifyRequest{ImageURL: "https://example.com/a.jpg"})
+	var rateLimitErr *RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfterDuration() != time.Hour {
+		t.Fatalf("err = %v, want a RateLimitError asking for an hour", err)
+	}
+	if n := requests.Load(); n != 1 || time.Since(start) > 5*time.Second {
+		t.Errorf("made %d requests in %v, want 1 without waiting", n, time.Since(start))
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	ctx := context.Background()
+	if wait, ok := retryWait(ctx, 1, NewRateLimitError("", 30, "")); !ok || wait != 30*time.Second {
+		t.Errorf("Retry-After 30s: wait = %v, %v", wait, ok)
+	}
+	if _, ok := retryWait(ctx, 1, NewRateLimitError("", 120, "")); ok {
+		t.Error("Retry-After beyond the cap was waited out")
+	}
+	short, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	if _, ok := retryWait(short, 1, NewRateLimitError("", 30, "")); ok {
+		t.Error("Retry-After beyond the context deadline was waited out")
+	}
+	if wait, _ := retryWait(ctx, 10, NewServerError("", 503, "")); wait != 10*time.Second {
+		t.Errorf("backoff after 10 failures = %v, want 10s", wait)
+	}
+}
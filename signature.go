@@ -0,0 +1,171 @@
+package actorhub
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// jwksPath is the location of ActorHub's public signing keys.
+	jwksPath = "/.well-known/jwks.json"
+
+	// jwksMinRefresh limits how often unknown key IDs trigger a JWKS refetch.
+	jwksMinRefresh = time.Minute
+)
+
+// ErrInvalidResponseSignature is returned when response verification is
+// enabled and a response is unsigned or its signature does not match.
+var ErrInvalidResponseSignature = errors.New("actorhub: invalid response signature")
+
+// WithResponseVerification enables verification of the ES256 X-Signature
+// header on every successful response against ActorHub's published JWKS.
+func WithResponseVerification() ClientOption {
+	return func(c *Client) {
+		c.verifyResponses = true
+	}
+}
+
+// jwksCache holds ActorHub's public signing keys by key ID. The mutex is
+// not held during a fetch; refreshing is set while one is in flight and
+// closed when it ends, so concurrent callers wait for it instead of
+// fetching again.
+type jwksCache struct {
+	mu         sync.Mutex
+	keys       map[string]*ecdsa.PublicKey
+	fetchedAt  time.Time
+	refreshing chan struct{}
+}
+
+// PublicKey returns ActorHub's signing key with the given key ID, fetching the
+// JWKS when the key is not cached. Use it to validate consent receipts with
+// VerifyReceipt.
+func (c *Client) PublicKey(ctx context.Context, keyID string) (crypto.PublicKey, error) {
+	j := c.jwks
+	for {
+		j.mu.Lock()
+		if key, ok := j.keys[keyID]; ok {
+			j.mu.Unlock()
+			return key, nil
+		}
+		if !j.fetchedAt.IsZero() && time.Since(j.fetchedAt) < jwksMinRefresh {
+			j.mu.Unlock()
+			return nil, fmt.Errorf("actorhub: unknown signing key %q", keyID)
+		}
+		if wait := j.refreshing; wait != nil {
+			j.mu.Unlock()
+			select {
+			case <-wait:
+				continue
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		done := make(chan struct{})
+		j.refreshing = done
+		j.mu.Unlock()
+
+		keys, err := c.fetchJWKS(ctx)
+
+		j.mu.Lock()
+		j.refreshing = nil
+		if err == nil {
+			j.keys = keys
+			j.fetchedAt = time.Now()
+		}
+		j.mu.Unlock()
+		close(done)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// fetchJWKS downloads and parses the EC P-256 keys from the JWKS endpoint.
+func (c *Client) fetchJWKS(ctx context.Context) (map[string]*ecdsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+jwksPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.doHTTP(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch signing keys: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch signing keys: HTTP %d", resp.StatusCode)
+	}
+
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Crv string `json:"crv"`
+			Kid string `json:"kid"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		} `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("failed to decode signing keys: %w", err)
+	}
+
+	keys := make(map[string]*ecdsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "EC" || k.Crv != "P-256" {
+			continue
+		}
+		x, errX := base64.RawURLEncoding.DecodeString(k.X)
+		y, errY := base64.RawURLEncoding.DecodeString(k.Y)
+		if errX != nil || errY != nil || len(x) != 32 || len(y) != 32 {
+			continue
+		}
+		// Reject points that are not on the curve.
+		point := append(append([]byte{4}, x...), y...)
+		if _, err := ecdh.P256().NewPublicKey(point); err != nil {
+			continue
+		}
+		keys[k.Kid] = &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+	}
+	return keys, nil
+}
+
+// verifyResponseSignature checks the X-Signature header, a base64url ES256
+// signature over the response body made with the key named by
+// X-Signature-Key-ID.
+func (c *Client) verifyResponseSignature(ctx context.Context, header http.Header, body []byte) error {
+	sig, err := base64.RawURLEncoding.DecodeString(header.Get("X-Signature"))
+	if err != nil || len(sig) != 64 {
+		return ErrInvalidResponseSignature
+	}
+
+	key, err := c.PublicKey(ctx, header.Get("X-Signature-Key-ID"))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidResponseSignature, err)
+	}
+
+	digest := sha256.Sum256(body)
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	if !ecdsa.Verify(key.(*ecdsa.PublicKey), digest[:], r, s) {
+		return ErrInvalidResponseSignature
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package actorhub
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// signingServer serves a JWKS with one key and signs each response body
+// with it, or with tamper applied to the body after signing.
+func signingServer(t *testing.T, body string, tamper bool, jwksDelay time.Duration, jwksFetches *atomic.Int32) *httptest.Server {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == jwksPath {
+			jwksFetches.Add(1)
+			time.Sleep(jwksDelay)
+			fmt.Fprintf(w, `{"keys":[{"kty":"EC","crv":"P-256","kid":"k1","x":"%s","y":"%s"}]}`,
+				base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+				base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))))
+			return
+		}
+		digest := sha256.Sum256([]byte(body))
+		sr, ss, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		sig := append(sr.FillBytes(make([]byte, 32)), ss.FillBytes(make([]byte, 32))...)
+		w.Header().Set("X-Signature", base64.RawURLEncoding.EncodeToString(sig))
+		w.Header().Set("X-Signature-Key-ID", "k1")
+		if tamper {
+			w.Write([]byte(body[:len(body)-1] + " "))
+			return
+		}
+		w.Write([]byte(body))
+	}))
+}
+
+func TestResponseVerification(t *testing.T) {
+	body := `{"protected":false,"faces_detected":0,"identities":[]}`
+	for _, tamper := range []bool{false, true} {
+		var fetches atomic.Int32
+		srv := signingServer(t, body, tamper, 0, &fetches)
+		c := NewClient("key", WithBaseURL(srv.URL), WithResponseVerification(), WithMaxRetries(1))
+		_, err := c.Verify(context.Background(), &VerifyRequest{ImageURL: "https://example.com/a.jpg"})
+		srv.Close()
+		if tamper && !errors.Is(err, ErrInvalidResponseSignature) {
+			t.Errorf("tampered body: err = %v, want ErrInvalidResponseSignature", err)
+		}
+		if !tamper && err != nil {
+			t.Errorf("signed body: %v", err)
+		}
+	}
+}
+
+func TestPublicKeyConcurrentRefresh(t *testing.T) {
+	var fetches atomic.Int32
+	srv := signingServer(t, "{}", false, 50*time.Millisecond, &fetches)
+	defer srv.Close()
+	c := NewClient("key", WithBaseURL(srv.URL))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.PublicKey(context.Background(), "k1"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := fetches.Load(); n != 1 {
+		t.Errorf("JWKS fetched %d times, want 1", n)
+	}
+
+	// A cached key is served while another caller waits on a slow refresh.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.PublicKey(ctx, "k1"); err != nil {
+		t.Errorf("cached key: %v", err)
+	}
+}
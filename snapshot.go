@@ -0,0 +1,113 @@
+package actorhub
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ErrInvalidSnapshot is returned when a downloaded consent snapshot does not
+// match its published digest or signature.
+var ErrInvalidSnapshot = errors.New("actorhub: invalid consent snapshot")
+
+// ConsentSnapshotInfo describes a signed consent snapshot for offline evaluation.
+type ConsentSnapshotInfo struct {
+	Version       string  `json:"version"`
+	BaseVersion   *string `json:"base_version,omitempty"` // set for delta snapshots
+	IsDelta       bool    `json:"is_delta"`
+	GeneratedAt   Time    `json:"generated_at"`
+	ExpiresAt     *Time   `json:"expires_at,omitempty"`
+	IdentityCount int     `json:"identity_count"`
+	SizeBytes     int64   `json:"size_bytes"`
+	Encoding      string  `json:"encoding"` // compression of the snapshot body, e.g. "gzip"
+	SHA256        string  `json:"sha256"`   // hex digest of the snapshot body
+	Signature     string  `json:"signature"`
+	KeyID         string  `json:"key_id"`
+}
+
+// IsStale reports whether the snapshot has passed its freshness deadline.
+func (s *ConsentSnapshotInfo) IsStale(now time.Time) bool {
+	return s.ExpiresAt != nil && now.After(s.ExpiresAt.Time)
+}
+
+// GetConsentSnapshotInfo retrieves metadata for the latest consent snapshot.
+// If sinceVersion is set, it describes the delta from that version instead.
+func (c *Client) GetConsentSnapshotInfo(ctx context.Context, sinceVersion string) (*ConsentSnapshotInfo, error) {
+	path := "/api/v1/consent/snapshots/latest"
+	if sinceVersion != "" {
+		path += "?" + url.Values{"since_version": {sinceVersion}}.Encode()
+	}
+
+	var result ConsentSnapshotInfo
+	err := c.doRequest(ctx, http.MethodGet, path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DownloadConsentSnapshot writes the latest full consent snapshot to w, still
+// compressed as described by the returned Encoding. The body is checked
+// against the published digest and ActorHub's signature; on error the data
+// written to w must be discarded.
+func (c *Client) DownloadConsentSnapshot(ctx context.Context, w io.Writer) (*ConsentSnapshotInfo, error) {
+	return c.downloadConsentSnapshot(ctx, "", w)
+}
+
+// DownloadConsentSnapshotDelta writes the changes since sinceVersion to w.
+// It is otherwise identical to DownloadConsentSnapshot.
+func (c *Client) DownloadConsentSnapshotDelta(ctx context.Context, sinceVersion string, w io.Writer) (*ConsentSnapshotInfo, error) {
+	if sinceVersion == "" {
+		return nil, NewValidationError("Must provide since_version", nil, "")
+	}
+	return c.downloadConsentSnapshot(ctx, sinceVersion, w)
+}
+
+func (c *Client) downloadConsentSnapshot(ctx context.Context, sinceVersion string, w io.Writer) (*ConsentSnapshotInfo, error) {
+	info, err := c.GetConsentSnapshotInfo(ctx, sinceVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	path := "/api/v1/consent/snapshots/" + info.Version + "/download"
+	if sinceVersion != "" {
+		path += "?" + url.Values{"since_version": {sinceVersion}}.Encode()
+	}
+
+	h := sha256.New()
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, io.MultiWriter(w, h)); err != nil {
+		return nil, err
+	}
+
+	digest := h.Sum(nil)
+	if want, err := hex.DecodeString(info.SHA256); err != nil || !bytes.Equal(digest, want) {
+		return nil, fmt.Errorf("%w: digest mismatch", ErrInvalidSnapshot)
+	}
+
+	key, err := c.PublicKey(ctx, info.KeyID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSnapshot, err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(info.Signature)
+	if err != nil || len(sig) != 64 {
+		return nil, fmt.Errorf("%w: malformed signature", ErrInvalidSnapshot)
+	}
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	if !ecdsa.Verify(key.(*ecdsa.PublicKey), digest, r, s) {
+		return nil, fmt.Errorf("%w: signature mismatch", ErrInvalidSnapshot)
+	}
+
+	return info, nil
+}
@@ -0,0 +1,67 @@
+package actorhub
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDownloadConsentSnapshotDigest(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshot := []byte("snapshot body")
+	digest := sha256.Sum256(snapshot)
+	sr, ss, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := base64.RawURLEncoding.EncodeToString(append(sr.FillBytes(make([]byte, 32)), ss.FillBytes(make([]byte, 32))...))
+
+	var published string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case jwksPath:
+			fmt.Fprintf(w, `{"keys":[{"kty":"EC","crv":"P-256","kid":"k1","x":"%s","y":"%s"}]}`,
+				base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+				base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))))
+		case "/api/v1/consent/snapshots/latest":
+			fmt.Fprintf(w, `{"version":"v1","sha256":%q,"signature":%q,"key_id":"k1"}`, published, sig)
+		default:
+			w.Write(snapshot)
+		}
+	}))
+	defer srv.Close()
+	c := NewClient("key", WithBaseURL(srv.URL))
+
+	for _, tt := range []struct {
+		digest string
+		ok     bool
+	}{
+		{hex.EncodeToString(digest[:]), true},
+		{strings.ToUpper(hex.EncodeToString(digest[:])), true},
+		{hex.EncodeToString(make([]byte, 32)), false},
+	} {
+		published = tt.digest
+		var buf bytes.Buffer
+		_, err := c.DownloadConsentSnapshot(context.Background(), &buf)
+		if tt.ok && err != nil {
+			t.Errorf("digest %s: %v", tt.digest, err)
+		}
+		if !tt.ok && !errors.Is(err, ErrInvalidSnapshot) {
+			t.Errorf("digest %s: err = %v, want ErrInvalidSnapshot", tt.digest, err)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package actorhub
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// statsWindow is how far back Stats looks.
+	statsWindow = 5 * time.Minute
+
+	// statsSamples is the number of recent calls kept per endpoint.
+	statsSamples = 1024
+)
+
+// EndpointStats summarizes recent calls to one endpoint.
+type EndpointStats struct {
+	Method   string
+	Endpoint string // path with IDs replaced by "{id}"
+
+	Requests  int
+	Errors    int     // calls that got no response, a 5xx, or a 429
+	ErrorRate float64 // Errors / Requests
+
+	P50 time.Duration
+	P95 time.Duration
+	P99 time.Duration
+}
+
+// Stats returns latency percentiles and error rates per endpoint over the
+// last five minutes, covering at most the 1024 most recent calls to each
+// endpoint. Latency includes retries. It gives services without a metrics
+// stack enough to make load-shedding decisions; use WithMetrics for full
+// monitoring.
+func (c *Client) Stats() []EndpointStats {
+	return c.stats.snapshot(time.Now())
+}
+
+// isServiceError reports whether a status code reflects a problem with the
+// service rather than with the request. Zero means no response.
+func isServiceError(statusCode int) bool {
+	return statusCode == 0 || statusCode == 429 || statusCode >= 500
+}
+
+// statsTracker keeps recent call samples per endpoint.
+type statsTracker struct {
+	mu        sync.Mutex
+	endpoints map[string]*endpointSamples
+}
+
+type callSample struct {
+	at       time.Time
+	duration time.Duration
+	failed   bool
+}
+
+// endpointSamples is a ring buffer of recent calls to one endpoint.
+type endpointSamples struct {
+	method   string
+	endpoint string
+	samples  []callSample
+	next     int
+}
+
+func (t *statsTracker) record(method, endpoint string, duration time.Duration, failed bool) {
+	key := method + " " + endpoint
+	sample := callSample{at: time.Now(), duration: duration, failed: failed}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	e, ok := t.endpoints[key]
+	if !ok {
+		e = &endpointSamples{method: method, endpoint: endpoint}
+		t.endpoints[key] = e
+	}
+	if len(e.samples) < statsSamples {
+		e.samples = append(e.samples, sample)
+		return
+	}
+	e.samples[e.next] = sample
+	e.next = (e.next + 1) % statsSamples
+}
+
+func (t *statsTracker) snapshot(now time.Time) []EndpointStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	result := make([]EndpointStats, 0, len(t.endpoints))
+	for _, e := range t.endpoints {
+		var durations []time.Duration
+		stats := EndpointStats{Method: e.method, Endpoint: e.endpoint}
+		for _, s := range e.samples {
+			if now.Sub(s.at) > statsWindow {
+				continue
+			}
+			durations = append(durations, s.duration)
+			if s.failed {
+				stats.Errors++
+			}
+		}
+		if len(durations) == 0 {
+			continue
+		}
+		sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+		stats.Requests = len(durations)
+		stats.ErrorRate = float64(stats.Errors) / float64(stats.Requests)
+		stats.P50 = percentile(durations, 0.50)
+		stats.P95 = percentile(durations, 0.95)
+		stats.P99 = percentile(durations, 0.99)
+		result = append(result, stats)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Endpoint != result[j].Endpoint {
+			return result[i].Endpoint < result[j].Endpoint
+		}
+		return result[i].Method < result[j].Method
+	})
+	return result
+}
+
+// percentile returns the p-th percentile of sorted durations using the
+// nearest-rank method.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(sorted) {
+		rank = len(sorted) - 1
+	}
+	return sorted[rank]
+}
@@ -0,0 +1,187 @@
+package actorhub
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// streamPollInterval is the minimum delay between stream results
+	// requests that return no new results.
+	streamPollInterval = time.Second
+
+	// streamPollWait is how long the server may hold a stream results request
+	// open waiting for new frames to be processed.
+	streamPollWait = 20 * time.Second
+)
+
+// StreamSessionRequest represents the options for a live stream verification
+// session.
+type StreamSessionRequest struct {
+	IncludeLicenseOptions bool   `json:"include_license_options,omitempty"`
+	ConsentEvidenceID     string `json:"consent_evidence_id,omitempty"`
+}
+
+// StreamResult represents the verification result for one pushed frame.
+type StreamResult struct {
+	Sequence      int64          `json:"sequence"`
+	Timestamp     float64        `json:"timestamp"` // seconds, as given to PushFrame
+	Identities    []VerifyResult `json:"identities"`
+	NewIdentities []string       `json:"new_identities"` // identity IDs first matched in this frame
+	Protected     bool           `json:"protected"`
+}
+
+// StreamSession is an open live stream verification session. Frames are
+// pushed with PushFrame and verified incrementally; results arrive on the
+// Results channel in frame order.
+type StreamSession struct {
+	ID string
+
+	client  *Client
+	results chan StreamResult
+	cancel  context.CancelFunc
+	done    chan struct{}
+
+	mu  sync.Mutex
+	err error
+}
+
+// StartStreamSession opens a live stream verification session. Identity
+// matches accumulate across frames, so a face seen earlier is recognized
+// again without re-matching from scratch. Results are polled under ctx, so
+// the session also ends when ctx is done; it must still be closed with
+// Close.
+func (c *Client) StartStreamSession(ctx context.Context, req *StreamSessionRequest) (*StreamSession, error) {
+	if req == nil {
+		req = &StreamSessionRequest{}
+	}
+	if c.requireBiometricConsent && req.ConsentEvidenceID == "" {
+		return nil, NewBiometricConsentRequiredError("")
+	}
+
+	var created struct {
+		SessionID string `json:"session_id"`
+	}
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/identity/verify/stream", req, &created)
+	if err != nil {
+		return nil, err
+	}
+
+	pollCtx, cancel := context.WithCancel(ctx)
+	s := &StreamSession{
+		ID:      created.SessionID,
+		client:  c,
+		results: make(chan StreamResult, 16),
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	go s.poll(pollCtx)
+
+	return s, nil
+}
+
+// PushFrame uploads an encoded video frame (JPEG or PNG) captured at
+// timestamp from the start of the stream. It returns once the frame is
+// queued; its result is delivered on Results.
+func (s *StreamSession) PushFrame(ctx context.Context, frame []byte, timestamp time.Duration) error {
+	fields := map[string]string{
+		"timestamp": strconv.FormatFloat(timestamp.Seconds(), 'f', -1, 64),
+	}
+	body, err := newMultipartBody(fields, "frame", "frame", bytes.NewReader(frame))
+	if err != nil {
+		return err
+	}
+
+	return s.client.doRequest(ctx, http.MethodPost, "/api/v1/identity/verify/stream/"+s.ID+"/frames", body, nil)
+}
+
+// Results returns the channel of frame results. It is closed when the
+// session is closed or fails; check Err afterwards.
+func (s *StreamSession) Results() <-chan StreamResult {
+	return s.results
+}
+
+// Err returns the error that ended the session, if any. With minor
+// protection enabled this is a MinorDetectedError when a frame contains a
+// face that may belong to a minor.
+func (s *StreamSession) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Close ends the session on the server and stops delivering results.
+func (s *StreamSession) Close(ctx context.Context) error {
+	s.cancel()
+	<-s.done
+	return s.client.doRequest(ctx, http.MethodDelete, "/api/v1/identity/verify/stream/"+s.ID, nil, nil)
+}
+
+func (s *StreamSession) poll(ctx context.Context) {
+	defer close(s.done)
+	defer close(s.results)
+
+	var after int64
+	for {
+		start := time.Now()
+		params := url.Values{}
+		params.Set("after", strconv.FormatInt(after, 10))
+		params.Set("wait", streamPollWait.String())
+
+		var page struct {
+			Results []StreamResult `json:"results"`
+			Closed  bool           `json:"closed"`
+		}
+		var md ResponseMetadata
+		err := s.client.doRequest(WithResponseMetadata(ctx, &md), http.MethodGet, "/api/v1/identity/verify/stream/"+s.ID+"/results?"+params.Encode(), nil, &page)
+		if err != nil {
+			if ctx.Err() == nil {
+				s.setErr(err)
+			}
+			return
+		}
+
+		for _, result := range page.Results {
+			if err := checkMinors(s.client, result.Identities, md.RequestID); err != nil {
+				s.setErr(err)
+				return
+			}
+
+			select {
+			case s.results <- result:
+			case <-ctx.Done():
+				return
+			}
+			after = result.Sequence
+		}
+
+		if page.Closed {
+			return
+		}
+
+		// A server that held the request already waited; poll again at once.
+		if len(page.Results) > 0 {
+			continue
+		}
+		delay := streamPollInterval - time.Since(start)
+		if delay <= 0 {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (s *StreamSession) setErr(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
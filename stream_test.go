@@ -0,0 +1,91 @@
+package actorhub
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStreamSessionMinorRequestID(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.Write([]byte(`{"session_id":"s1"}`))
+			return
+		}
+		w.Header().Set("X-Request-ID", "req_42")
+		w.Write([]byte(`{"results":[{"sequence":1,"identities":[{"estimated_minor":true}]}]}`))
+	}))
+	defer srv.Close()
+	c := NewClient("key", WithBaseURL(srv.URL), WithMinorProtection())
+
+	s, err := c.StartStreamSession(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range s.Results() {
+	}
+	var minorErr *MinorDetectedError
+	if !errors.As(s.Err(), &minorErr) || minorErr.RequestID != "req_42" {
+		t.Errorf("Err = %v, want MinorDetectedError with request ID req_42", s.Err())
+	}
+}
+
+func TestStreamSessionStopsWithContext(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.Write([]byte(`{"session_id":"s1"}`))
+			return
+		}
+		if strings.Contains(r.URL.Path, "/results") {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+		}
+	}))
+	defer srv.Close()
+	c := NewClient("key", WithBaseURL(srv.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s, err := c.StartStreamSession(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	select {
+	case <-s.done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("poll still running after the context was canceled")
+	}
+}
+
+func TestStreamSessionThrottlesEmptyPages(t *testing.T) {
+	var polls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.Write([]byte(`{"session_id":"s1"}`))
+			return
+		}
+		polls.Add(1)
+		w.Write([]byte(`{"results":[]}`))
+	}))
+	defer srv.Close()
+	c := NewClient("key", WithBaseURL(srv.URL))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s, err := c.StartStreamSession(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(200 * time.Millisecond)
+	cancel()
+	<-s.done
+	if n := polls.Load(); n > 2 {
+		t.Errorf("polls = %d, want at most 2 for empty pages within one interval", n)
+	}
+}
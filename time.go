@@ -0,0 +1,66 @@
+package actorhub
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Time is a timestamp in an API response. It embeds time.Time, so its
+// methods are available directly, and decodes the timestamp variants the API
+// has used: RFC 3339 with or without fractional seconds, a space in place of
+// the "T", an offset without a colon, no zone at all (taken as UTC), a bare
+// date, and Unix seconds as a JSON number. The result is always in UTC.
+//
+// A timestamp in none of these forms decodes as the zero Time instead of
+// failing the whole response; check IsZero where it matters.
+type Time struct {
+	time.Time
+}
+
+// timeLayouts are tried in order by parseTime. Fractional seconds are
+// optional in each: time.Parse accepts them after a seconds field even when
+// the layout has none.
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05Z0700",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// parseTime parses s in any of timeLayouts, in UTC.
+func parseTime(s string) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	t.Time = time.Time{}
+	if len(data) > 0 && data[0] != '"' {
+		if secs, err := strconv.ParseFloat(string(data), 64); err == nil {
+			t.Time = time.UnixMilli(int64(secs * 1000)).UTC()
+		}
+		return nil
+	}
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return nil
+	}
+	t.Time, _ = parseTime(s)
+	return nil
+}
@@ -0,0 +1,128 @@
+package actorhub
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrUploadTimeout is returned when connecting and sending a request body
+	// takes longer than the upload timeout.
+	ErrUploadTimeout = errors.New("actorhub: upload timeout exceeded")
+
+	// ErrProcessingTimeout is returned when the API takes longer than the
+	// processing timeout to start responding after the request was sent.
+	ErrProcessingTimeout = errors.New("actorhub: processing timeout exceeded")
+)
+
+// WithUploadTimeout limits the time to connect and send each request,
+// including its body. Together with WithProcessingTimeout it replaces the
+// single overall timeout set by WithTimeout, which cannot tell a slow upload
+// of a large image from slow model inference. If only one of the two is
+// set, the other phase is limited by the overall timeout, DefaultTimeout
+// unless WithTimeout changed it.
+func WithUploadTimeout(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.uploadTimeout = d
+	}
+}
+
+// WithProcessingTimeout limits the time the API may take to start responding
+// once a request has been sent. Reading the response body is not limited, so
+// large downloads are unaffected. See WithUploadTimeout.
+func WithProcessingTimeout(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.processingTimeout = d
+	}
+}
+
+// responseTimeout returns how long a request may wait for the API to
+// respond, or zero if there is no limit.
+func (c *Client) responseTimeout() time.Duration {
+	if c.processingTimeout > 0 {
+		return c.processingTimeout
+	}
+	return c.httpClient.Timeout
+}
+
+// phaseTimeouts returns a context that is canceled when the upload or
+// processing phase of a request exceeds its timeout. stop must be called once
+// the response has been handled.
+func (c *Client) phaseTimeouts(ctx context.Context) (context.Context, func()) {
+	if c.uploadTimeout <= 0 && c.processingTimeout <= 0 {
+		return ctx, func() {}
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	var mu sync.Mutex
+	var timer *time.Timer
+	arm := func(d time.Duration, cause error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+		}
+		if d > 0 {
+			timer = time.AfterFunc(d, func() { cancel(cause) })
+		}
+	}
+
+	arm(c.uploadTimeout, ErrUploadTimeout)
+	trace := &httptrace.ClientTrace{
+		WroteRequest: func(httptrace.WroteRequestInfo) {
+			arm(c.processingTimeout, ErrProcessingTimeout)
+		},
+		GotFirstResponseByte: func() {
+			arm(0, nil)
+		},
+	}
+
+	return httptrace.WithClientTrace(ctx, trace), func() {
+		arm(0, nil)
+		cancel(nil)
+	}
+}
+
+// doHTTP sends a request made outside doRequestOnce, such as a download,
+// upload, or key fetch, under the client's phase timeouts. Closing the
+// response body releases them.
+func (c *Client) doHTTP(req *http.Request) (*http.Response, error) {
+	ctx, stop := c.phaseTimeouts(req.Context())
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		if timeoutErr := phaseTimeoutErr(ctx); timeoutErr != nil {
+			err = timeoutErr
+		}
+		stop()
+		return nil, err
+	}
+	resp.Body = &stopOnClose{ReadCloser: resp.Body, stop: stop}
+	return resp, nil
+}
+
+// stopOnClose releases a request's phase timeouts when its body is closed.
+type stopOnClose struct {
+	io.ReadCloser
+	stop func()
+}
+
+func (b *stopOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.stop()
+	return err
+}
+
+// phaseTimeoutErr returns the phase timeout that canceled ctx, if any.
+func phaseTimeoutErr(ctx context.Context) error {
+	cause := context.Cause(ctx)
+	if errors.Is(cause, ErrUploadTimeout) || errors.Is(cause, ErrProcessingTimeout) {
+		return cause
+	}
+	return nil
+}
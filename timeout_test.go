@@ -0,0 +1,41 @@
+package actorhub
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDownloadHonorsProcessingTimeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	c := NewClient("key", WithBaseURL(srv.URL), WithMaxRetries(1), WithProcessingTimeout(20*time.Millisecond))
+	_, err := c.Download(context.Background(), "/api/v1/files/f_1", &bytes.Buffer{})
+	if !errors.Is(err, ErrProcessingTimeout) {
+		t.Errorf("err = %v, want ErrProcessingTimeout", err)
+	}
+}
+
+func TestUnsetPhaseTimeoutKeepsOverallTimeout(t *testing.T) {
+	c := NewClient("key", WithUploadTimeout(time.Second))
+	if c.processingTimeout != DefaultTimeout {
+		t.Errorf("processingTimeout = %v, want %v", c.processingTimeout, DefaultTimeout)
+	}
+
+	c = NewClient("key", WithTimeout(5*time.Second), WithProcessingTimeout(time.Second))
+	if c.uploadTimeout != 5*time.Second {
+		t.Errorf("uploadTimeout = %v, want %v", c.uploadTimeout, 5*time.Second)
+	}
+	if c.httpClient.Timeout != 0 {
+		t.Errorf("httpClient.Timeout = %v, want 0", c.httpClient.Timeout)
+	}
+}
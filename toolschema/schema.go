@@ -0,0 +1,134 @@
+package toolschema
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	actorhub "github.com/actorhubai/actorhub-go"
+)
+
+// enums lists the values of SDK string types, emitted as JSON Schema enums.
+var enums = map[reflect.Type][]string{
+	reflect.TypeOf(actorhub.Platform("")): {
+		string(actorhub.PlatformRunway),
+		string(actorhub.PlatformPika),
+		string(actorhub.PlatformKling),
+		string(actorhub.PlatformSora),
+		string(actorhub.PlatformOpenAI),
+		string(actorhub.PlatformStability),
+		string(actorhub.PlatformComfyUI),
+	},
+	reflect.TypeOf(actorhub.IntendedUse("")): {
+		string(actorhub.IntendedUseImage),
+		string(actorhub.IntendedUseVideo),
+		string(actorhub.IntendedUseAudio),
+		string(actorhub.IntendedUseAITraining),
+	},
+}
+
+var (
+	timeType  = reflect.TypeOf(time.Time{})
+	moneyType = reflect.TypeOf(actorhub.Money{})
+)
+
+// schemaFor derives a JSON Schema from a request struct's JSON encoding.
+// Fields without omitempty are required; fields named in omit are left out.
+func schemaFor(t reflect.Type, descriptions map[string]string, omit []string) map[string]interface{} {
+	properties := map[string]interface{}{}
+	required := []string{}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, optional, ok := jsonName(field)
+		if !ok || contains(omit, name) {
+			continue
+		}
+
+		prop := typeSchema(field.Type)
+		if desc, ok := descriptions[name]; ok {
+			prop["description"] = desc
+		}
+		properties[name] = prop
+		if !optional {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+func typeSchema(t reflect.Type) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+	if t == moneyType {
+		return map[string]interface{}{"type": "number"}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		schema := map[string]interface{}{"type": "string"}
+		if values, ok := enums[t]; ok {
+			schema["enum"] = values
+		}
+		return schema
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": typeSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": typeSchema(t.Elem())}
+	case reflect.Struct:
+		return schemaFor(t, nil, nil)
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+// jsonName returns the JSON name of a field and whether it is omitempty. ok
+// is false for fields excluded from JSON.
+func jsonName(field reflect.StructField) (name string, optional, ok bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false, false
+	}
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	if name == "" {
+		name = field.Name
+	}
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			optional = true
+		}
+	}
+	return name, optional, true
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
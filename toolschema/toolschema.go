@@ -0,0 +1,193 @@
+// Package toolschema describes the main SDK operations as tools for LLM
+// function calling and dispatches tool calls back to the SDK.
+//
+// Tool schemas are derived from the SDK's request structs, so they stay in
+// sync as fields are added:
+//
+//	body := map[string]interface{}{
+//	    "model":    "gpt-4o",
+//	    "messages": messages,
+//	    "tools":    toolschema.Export(toolschema.FormatOpenAI),
+//	}
+//
+//	// When the model calls a tool:
+//	d := toolschema.NewDispatcher(client)
+//	result, err := d.Dispatch(ctx, call.Function.Name, json.RawMessage(call.Function.Arguments))
+package toolschema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	actorhub "github.com/actorhubai/actorhub-go"
+)
+
+// Format selects the provider format of exported tool definitions.
+type Format string
+
+const (
+	FormatOpenAI    Format = "openai"    // {"type": "function", "function": {..., "parameters": ...}}
+	FormatAnthropic Format = "anthropic" // {"name": ..., "description": ..., "input_schema": ...}
+)
+
+// Tool describes an SDK operation callable by an LLM.
+type Tool struct {
+	Name        string
+	Description string
+	Schema      map[string]interface{} // JSON Schema of the arguments
+
+	call func(ctx context.Context, client *actorhub.Client, args json.RawMessage) (interface{}, error)
+}
+
+// define builds a tool whose arguments are the JSON encoding of Req. Fields
+// in omit are left out of the schema and rejected in calls, since they are
+// the integrator's to set, not the model's.
+func define[Req any](name, description string, descriptions map[string]string, omit []string, call func(ctx context.Context, client *actorhub.Client, req *Req) (interface{}, error)) Tool {
+	return Tool{
+		Name:        name,
+		Description: description,
+		Schema:      schemaFor(reflect.TypeOf((*Req)(nil)).Elem(), descriptions, omit),
+		call: func(ctx context.Context, client *actorhub.Client, args json.RawMessage) (interface{}, error) {
+			var req Req
+			if len(args) > 0 {
+				var fields map[string]json.RawMessage
+				if err := json.Unmarshal(args, &fields); err != nil {
+					return nil, fmt.Errorf("toolschema: invalid arguments for %s: %w", name, err)
+				}
+				// Decoding matches field names case-insensitively, and so must this.
+				for key := range fields {
+					for _, field := range omit {
+						if strings.EqualFold(key, field) {
+							return nil, fmt.Errorf("toolschema: invalid arguments for %s: field %q is not allowed", name, key)
+						}
+					}
+				}
+				dec := json.NewDecoder(bytes.NewReader(args))
+				dec.DisallowUnknownFields()
+				if err := dec.Decode(&req); err != nil {
+					return nil, fmt.Errorf("toolschema: invalid arguments for %s: %w", name, err)
+				}
+			}
+			return call(ctx, client, &req)
+		},
+	}
+}
+
+var imageDescriptions = map[string]string{
+	"image_url":    "Public URL of the image.",
+	"image_base64": "Base64-encoded image data, if there is no URL.",
+}
+
+var tools = []Tool{
+	define("verify_image",
+		"Check whether an image contains the face of a protected person registered with ActorHub. "+
+			"Use this on any image of a real person before editing, animating, or publishing it.",
+		imageDescriptions,
+		[]string{"upload_id", "consent_evidence_id"},
+		func(ctx context.Context, client *actorhub.Client, req *actorhub.VerifyRequest) (interface{}, error) {
+			return client.Verify(ctx, req)
+		}),
+	define("check_consent",
+		"Check whether the people in an image have consented to AI generation on a platform. "+
+			"Call this before generating any image or video from a reference photo, and do not proceed if consent is missing.",
+		map[string]string{
+			"image_url":    imageDescriptions["image_url"],
+			"image_base64": imageDescriptions["image_base64"],
+			"platform":     "Generation platform, e.g. runway, pika, kling, sora.",
+			"intended_use": "How the output will be used: image, video, audio, or ai_training.",
+			"region":       "ISO country code where the output will be published.",
+		},
+		[]string{"face_embedding", "upload_id", "regions", "consent_token", "include_receipt", "consent_evidence_id"},
+		func(ctx context.Context, client *actorhub.Client, req *actorhub.ConsentCheckRequest) (interface{}, error) {
+			return client.CheckConsent(ctx, req)
+		}),
+	define("screen_prompt",
+		"Screen a generation prompt for references to protected people by name and for blocked content categories. "+
+			"Call this before sending any prompt that mentions a real person to an image, video, or voice model.",
+		map[string]string{
+			"prompt":          "The generation prompt.",
+			"negative_prompt": "The negative prompt, if any.",
+			"platform":        "Generation platform.",
+		},
+		nil,
+		func(ctx context.Context, client *actorhub.Client, req *actorhub.PromptScreenRequest) (interface{}, error) {
+			return client.ScreenPrompt(ctx, req)
+		}),
+	define("verify_voice",
+		"Check whether an audio clip matches the voice of a protected person registered with ActorHub.",
+		map[string]string{
+			"audio_url":    "Public URL of the audio clip.",
+			"audio_base64": "Base64-encoded audio data, if there is no URL.",
+		},
+		[]string{"consent_evidence_id"},
+		func(ctx context.Context, client *actorhub.Client, req *actorhub.VoiceVerifyRequest) (interface{}, error) {
+			return client.VerifyVoice(ctx, req)
+		}),
+	define("search_marketplace",
+		"Search the ActorHub marketplace for identities whose likeness can be licensed for AI generation.",
+		map[string]string{
+			"query":    "Free-text search.",
+			"category": "Marketplace category.",
+		},
+		[]string{"featured", "min_price", "max_price", "sort_by", "fields"},
+		func(ctx context.Context, client *actorhub.Client, req *actorhub.MarketplaceListRequest) (interface{}, error) {
+			return client.ListMarketplace(ctx, req)
+		}),
+}
+
+// Tools returns the SDK operations exposed as tools.
+func Tools() []Tool {
+	return append([]Tool(nil), tools...)
+}
+
+// Export returns the tool definitions in the given provider format, ready to
+// include in a chat completion or messages request.
+func Export(format Format) []interface{} {
+	out := make([]interface{}, 0, len(tools))
+	for _, t := range tools {
+		switch format {
+		case FormatAnthropic:
+			out = append(out, map[string]interface{}{
+				"name":         t.Name,
+				"description":  t.Description,
+				"input_schema": t.Schema,
+			})
+		default:
+			out = append(out, map[string]interface{}{
+				"type": "function",
+				"function": map[string]interface{}{
+					"name":        t.Name,
+					"description": t.Description,
+					"parameters":  t.Schema,
+				},
+			})
+		}
+	}
+	return out
+}
+
+// Dispatcher runs tool calls against an ActorHub client.
+type Dispatcher struct {
+	client *actorhub.Client
+}
+
+// NewDispatcher creates a Dispatcher.
+func NewDispatcher(client *actorhub.Client) *Dispatcher {
+	return &Dispatcher{client: client}
+}
+
+// Dispatch decodes a tool call's JSON arguments into the matching SDK request
+// and runs it. Unknown argument fields, and fields left out of the tool's
+// schema, are rejected.
+func (d *Dispatcher) Dispatch(ctx context.Context, name string, arguments json.RawMessage) (interface{}, error) {
+	for _, t := range tools {
+		if t.Name == name {
+			return t.call(ctx, d.client, arguments)
+		}
+	}
+	return nil, fmt.Errorf("toolschema: unknown tool %q", name)
+}
@@ -0,0 +1,56 @@
+package toolschema
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	actorhub "github.com/actorhubai/actorhub-go"
+)
+
+func TestDispatchRejectsOmittedFields(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"protected":false,"faces":[]}`))
+	}))
+	defer srv.Close()
+	d := NewDispatcher(actorhub.NewClient("key", actorhub.WithBaseURL(srv.URL)))
+	ctx := context.Background()
+
+	for _, args := range []string{
+		`{"image_url":"https://example.com/a.jpg","platform":"runway","intended_use":"video","consent_token":"forged"}`,
+		`{"image_url":"https://example.com/a.jpg","platform":"runway","intended_use":"video","CONSENT_TOKEN":"forged"}`,
+		`{"platform":"runway","intended_use":"video","upload_id":"up_1"}`,
+	} {
+		_, err := d.Dispatch(ctx, "check_consent", json.RawMessage(args))
+		if err == nil || !strings.Contains(err.Error(), "not allowed") {
+			t.Errorf("Dispatch(%s) err = %v, want field not allowed", args, err)
+		}
+	}
+	if calls != 0 {
+		t.Errorf("%d requests reached the API", calls)
+	}
+
+	_, err := d.Dispatch(ctx, "check_consent", json.RawMessage(`{"image_url":"https://example.com/a.jpg","platform":"runway","intended_use":"video"}`))
+	if err != nil {
+		t.Errorf("allowed arguments: %v", err)
+	}
+}
+
+func TestSchemaOmitsFields(t *testing.T) {
+	for _, tool := range Tools() {
+		props, ok := tool.Schema["properties"].(map[string]interface{})
+		if !ok {
+			t.Fatalf("%s schema has no properties", tool.Name)
+		}
+		for _, field := range []string{"consent_token", "consent_evidence_id", "upload_id"} {
+			if _, ok := props[field]; ok {
+				t.Errorf("%s schema exposes %s", tool.Name, field)
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package actorhub
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+)
+
+// rawBody is a pre-encoded request body sent as-is instead of as JSON. It is
+// held in memory so the request can be retried.
+type rawBody struct {
+	contentType string
+	data        []byte
+}
+
+// newMultipartBody encodes fields and a single file read from r as
+// multipart/form-data. The file's content type is sniffed from its contents.
+func newMultipartBody(fields map[string]string, fileField, fileName string, r io.Reader) (*rawBody, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upload: %w", err)
+	}
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	for name, value := range fields {
+		if err := mw.WriteField(name, value); err != nil {
+			return nil, fmt.Errorf("failed to encode upload: %w", err)
+		}
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name=%q; filename=%q`, fileField, fileName))
+	header.Set("Content-Type", http.DetectContentType(data))
+	part, err := mw.CreatePart(header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode upload: %w", err)
+	}
+	if _, err := part.Write(data); err != nil {
+		return nil, fmt.Errorf("failed to encode upload: %w", err)
+	}
+	if err := mw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode upload: %w", err)
+	}
+
+	return &rawBody{contentType: mw.FormDataContentType(), data: buf.Bytes()}, nil
+}
+
+// CreateUpload reserves a presigned upload slot for size bytes of media of
+// the given content type. PUT the bytes to the returned URL before it
+// expires, then pass UploadID in place of base64 media in Verify or
+// CheckConsent; UploadMedia does both steps. Uploads are not limited to the
+// size of a JSON request body.
+func (c *Client) CreateUpload(ctx context.Context, contentType string, size int64) (*Upload, error) {
+	if contentType == "" {
+		return nil, NewValidationError("Must provide content_type", nil, "")
+	}
+	if size <= 0 {
+		return nil, NewValidationError("size must be positive", nil, "")
+	}
+
+	body := map[string]interface{}{
+		"content_type": contentType,
+		"size_bytes":   size,
+	}
+
+	var result Upload
+	err := c.doRequest(ctx, http.MethodPost, "/api/v1/uploads", body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UploadMedia uploads size bytes of media read from r through a presigned
+// upload and returns the upload, whose UploadID Verify and CheckConsent
+// requests can reference. The data is streamed rather than held in memory;
+// r must yield exactly size bytes. The content type is sniffed from the
+// first bytes if empty. The bytes go straight to storage unencrypted, so
+// UploadMedia fails with ErrEncryptionUnsupported while
+// WithPayloadEncryption is enabled.
+func (c *Client) UploadMedia(ctx context.Context, contentType string, r io.Reader, size int64) (*Upload, error) {
+	if c.encryption != nil {
+		return nil, ErrEncryptionUnsupported
+	}
+	if contentType == "" {
+		head := make([]byte, 512)
+		n, err := io.ReadFull(r, head)
+		if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+			return nil, fmt.Errorf("failed to read upload: %w", err)
+		}
+		contentType = http.DetectContentType(head[:n])
+		r = io.MultiReader(bytes.NewReader(head[:n]), r)
+	}
+
+	upload, err := c.CreateUpload(ctx, contentType, size)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.putUpload(ctx, upload, contentType, r, size); err != nil {
+		return nil, err
+	}
+	return upload, nil
+}
+
+// putUpload streams size bytes from r to a presigned upload URL. The URL
+// carries its own authorization, so the API key is not sent.
+func (c *Client) putUpload(ctx context.Context, upload *Upload, contentType string, r io.Reader, size int64) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, upload.URL, r)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+	for name, value := range upload.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := c.doHTTP(req)
+	if err != nil {
+		return fmt.Errorf("failed to upload media: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to upload media: HTTP %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package actorhub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUploadMediaStreamsWithLength(t *testing.T) {
+	png := "\x89PNG\r\n\x1a\n" + strings.Repeat("x", 4096)
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/uploads":
+			var req struct {
+				ContentType string `json:"content_type"`
+				SizeBytes   int64  `json:"size_bytes"`
+			}
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.ContentType != "image/png" || req.SizeBytes != int64(len(png)) {
+				t.Errorf("CreateUpload got %+v", req)
+			}
+			fmt.Fprintf(w, `{"upload_id":"up_1","url":"%s/storage/up_1"}`, srv.URL)
+		case "/storage/up_1":
+			if r.ContentLength != int64(len(png)) || r.Header.Get("X-API-Key") != "" {
+				t.Errorf("PUT Content-Length = %d, X-API-Key = %q", r.ContentLength, r.Header.Get("X-API-Key"))
+			}
+			if body, _ := io.ReadAll(r.Body); string(body) != png {
+				t.Errorf("PUT body is %d bytes, want the media", len(body))
+			}
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", WithBaseURL(srv.URL), WithMaxRetries(1))
+	// A plain io.Reader, so the length must come from the caller.
+	r := io.MultiReader(strings.NewReader(png))
+	upload, err := c.UploadMedia(context.Background(), "", r, int64(len(png)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if upload.UploadID != "up_1" {
+		t.Errorf("UploadID = %q", upload.UploadID)
+	}
+}
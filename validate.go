@@ -0,0 +1,361 @@
+package actorhub
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+)
+
+// Request types have a Validate method that checks them locally before they
+// are sent, saving a round trip on mistakes the API would reject. Client
+// methods call it automatically. Only the shape of a request is checked;
+// limits and sets of values the server may extend, such as page sizes,
+// embedding dimensions, and license types, are left to the server. Failures are reported as a ValidationError
+// whose Errors map is keyed by the JSON path of each invalid field, such as
+// "evidence[0].data_base64".
+
+// validator collects field errors.
+type validator struct {
+	errors  map[string]interface{}
+	message string
+}
+
+// fail records a problem with a field. The first problem becomes the
+// error message.
+func (v *validator) fail(field, message string) {
+	if v.errors == nil {
+		v.errors = make(map[string]interface{})
+	}
+	if _, ok := v.errors[field]; !ok {
+		v.errors[field] = message
+	}
+	if v.message == "" {
+		v.message = message
+	}
+}
+
+// err returns the collected errors as a ValidationError, or nil.
+func (v *validator) err() error {
+	if v.errors == nil {
+		return nil
+	}
+	return NewValidationError(v.message, v.errors, "")
+}
+
+func (v *validator) required(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.fail(field, "Must provide "+field)
+	}
+}
+
+// url checks that a non-empty value is an absolute http or https URL.
+func (v *validator) url(field, value string) {
+	if value == "" {
+		return
+	}
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		v.fail(field, field+" must be an absolute http or https URL")
+	}
+}
+
+// base64 checks that a non-empty value is standard or URL-safe base64,
+// optionally as a data URI.
+func (v *validator) base64(field, value string) {
+	if value == "" {
+		return
+	}
+	if strings.HasPrefix(value, "data:") {
+		i := strings.Index(value, ";base64,")
+		if i < 0 {
+			v.fail(field, field+" must be base64 encoded")
+			return
+		}
+		value = value[i+len(";base64,"):]
+	}
+	data := strings.TrimRight(value, "=")
+	if data == "" || len(value)-len(data) > 2 {
+		v.fail(field, field+" must be base64 encoded")
+		return
+	}
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		if !('A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' ||
+			c == '+' || c == '/' || c == '-' || c == '_' || c == '\n' || c == '\r') {
+			v.fail(field, fmt.Sprintf("%s must be base64 encoded; invalid character at offset %d", field, i))
+			return
+		}
+	}
+}
+
+// media checks a URL/base64 pair of which at least one is required.
+func (v *validator) media(urlField, url, base64Field, base64 string) {
+	if url == "" && base64 == "" {
+		v.fail(urlField, fmt.Sprintf("Must provide %s or %s", urlField, base64Field))
+		return
+	}
+	v.url(urlField, url)
+	v.base64(base64Field, base64)
+}
+
+func (v *validator) embedding(field string, values []float64) {
+	for i, x := range values {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			v.fail(fmt.Sprintf("%s[%d]", field, i), fmt.Sprintf("%s must contain only finite numbers", field))
+			return
+		}
+	}
+}
+
+func (v *validator) nonNegative(field string, n int) {
+	if n < 0 {
+		v.fail(field, field+" must not be negative")
+	}
+}
+
+// enum checks that a non-empty value is known.
+func (v *validator) enum(field, value string, known bool) {
+	if value != "" && !known {
+		v.fail(field, fmt.Sprintf("Unknown %s %q", field, value))
+	}
+}
+
+func (v *validator) evidence(field string, files []EvidenceFile) {
+	for i, f := range files {
+		path := fmt.Sprintf("%s[%d]", field, i)
+		if f.URL == "" && f.DataBase64 == "" {
+			v.fail(path+".url", fmt.Sprintf("Must provide %s.url or %s.data_base64", path, path))
+			continue
+		}
+		v.url(path+".url", f.URL)
+		v.base64(path+".data_base64", f.DataBase64)
+	}
+}
+
+// itemError places a validation error from element i of a list field under
+// that element, so that "identity_id" becomes "items[2].identity_id".
+func itemError(field string, i int, err error) error {
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		return err
+	}
+	path := fmt.Sprintf("%s[%d]", field, i)
+	var v validator
+	for key, message := range verr.Errors {
+		v.fail(path+"."+key, fmt.Sprint(message))
+	}
+	v.message = path + ": " + verr.Message
+	return v.err()
+}
+
+// Validate checks the request locally.
+func (r *VerifyRequest) Validate() error {
+	var v validator
+	if r.UploadID == "" {
+		v.media("image_url", r.ImageURL, "image_base64", r.ImageBase64)
+	} else {
+		v.url("image_url", r.ImageURL)
+		v.base64("image_base64", r.ImageBase64)
+	}
+	return v.err()
+}
+
+// Validate checks the input locally.
+func (in ImageInput) Validate() error {
+	var v validator
+	v.media("image_url", in.URL, "image_base64", in.Base64)
+	return v.err()
+}
+
+// Validate checks the request locally.
+func (r *VoiceVerifyRequest) Validate() error {
+	var v validator
+	v.media("audio_url", r.AudioURL, "audio_base64", r.AudioBase64)
+	return v.err()
+}
+
+// Validate checks the request locally.
+func (r *MultiModalVerifyRequest) Validate() error {
+	var v validator
+	v.media("image_url", r.ImageURL, "image_base64", r.ImageBase64)
+	v.media("audio_url", r.AudioURL, "audio_base64", r.AudioBase64)
+	return v.err()
+}
+
+// Validate checks the request locally.
+func (r *VideoVerifyRequest) Validate() error {
+	var v validator
+	sources := 0
+	for _, set := range []bool{r.VideoURL != "", r.Video != nil, r.UploadID != ""} {
+		if set {
+			sources++
+		}
+	}
+	if sources != 1 {
+		v.fail("video_url", "Must provide exactly one of video_url, video, or upload_id")
+	}
+	v.url("video_url", r.VideoURL)
+	if r.SampleFPS < 0 {
+		v.fail("sample_fps", "sample_fps must not be negative")
+	}
+	return v.err()
+}
+
+// Validate checks the request locally. Platform must be set; whether it is
+// known is checked by Client.CheckConsent, which also accepts platforms
+// registered with WithPlatforms.
+func (r *ConsentCheckRequest) Validate() error {
+	var v validator
+	if r.ImageURL == "" && r.ImageBase64 == "" && len(r.FaceEmbedding) == 0 && r.UploadID == "" {
+		v.fail("image_url", "Must provide image_url, image_base64, face_embedding, or upload_id")
+	}
+	v.url("image_url", r.ImageURL)
+	v.base64("image_base64", r.ImageBase64)
+	v.embedding("face_embedding", r.FaceEmbedding)
+	v.required("platform", string(r.Platform))
+	v.required("intended_use", string(r.IntendedUse))
+	v.enum("intended_use", string(r.IntendedUse), r.IntendedUse.IsKnown())
+	return v.err()
+}
+
+// Validate checks the request locally.
+func (r *PromptScreenRequest) Validate() error {
+	var v validator
+	v.required("prompt", r.Prompt)
+	return v.err()
+}
+
+// Validate checks the request locally.
+func (r *MarketplaceListRequest) Validate() error {
+	var v validator
+	v.nonNegative("page", r.Page)
+	v.nonNegative("limit", r.Limit)
+	if r.MinPrice != nil && r.MinPrice.Cmp(Money{}) < 0 {
+		v.fail("min_price", "min_price must not be negative")
+	}
+	if r.MinPrice != nil && r.MaxPrice != nil && r.MaxPrice.Cmp(*r.MinPrice) < 0 {
+		v.fail("max_price", "max_price must not be less than min_price")
+	}
+	return v.err()
+}
+
+// Validate checks the request locally.
+func (r *PurchaseLicenseRequest) Validate() error {
+	var v validator
+	v.required("identity_id", r.IdentityID)
+	v.nonNegative("duration_days", r.DurationDays)
+	if r.MaxImpressions != nil {
+		v.nonNegative("max_impressions", *r.MaxImpressions)
+	}
+	if r.MaxOutputs != nil {
+		v.nonNegative("max_outputs", *r.MaxOutputs)
+	}
+	return v.err()
+}
+
+// Validate checks the request locally.
+func (r *AttachPaymentMethodRequest) Validate() error {
+	var v validator
+	v.required("payment_method_token", r.PaymentMethodToken)
+	return v.err()
+}
+
+// Validate checks the request locally.
+func (r *CreateDisputeRequest) Validate() error {
+	var v validator
+	v.required("target_type", string(r.TargetType))
+	v.required("target_id", r.TargetID)
+	v.evidence("evidence", r.Evidence)
+	return v.err()
+}
+
+// Validate checks the request locally.
+func (r *ConnectPayoutAccountRequest) Validate() error {
+	var v validator
+	v.required("country", r.Country)
+	if r.Country != "" && len(r.Country) != 2 {
+		v.fail("country", "country must be a two-letter ISO 3166-1 code")
+	}
+	v.url("return_url", r.ReturnURL)
+	return v.err()
+}
+
+// Validate checks the request locally.
+func (r *TransactionListRequest) Validate() error {
+	var v validator
+	v.nonNegative("limit", r.Limit)
+	if !r.Since.IsZero() && !r.Until.IsZero() && r.Until.Before(r.Since) {
+		v.fail("until", "until must not be before since")
+	}
+	return v.err()
+}
+
+// Validate checks the request locally.
+func (r *CreateAPIKeyRequest) Validate() error {
+	var v validator
+	v.required("name", r.Name)
+	return v.err()
+}
+
+// Validate checks the request locally.
+func (r *TakedownRequest) Validate() error {
+	var v validator
+	v.required("identity_id", r.IdentityID)
+	v.required("content_url", r.ContentURL)
+	v.url("content_url", r.ContentURL)
+	v.evidence("evidence", r.Evidence)
+	return v.err()
+}
+
+// Validate checks the request locally.
+func (r *WatermarkEmbedRequest) Validate() error {
+	var v validator
+	v.media("image_url", r.ImageURL, "image_base64", r.ImageBase64)
+	v.required("license_id", r.LicenseID)
+	return v.err()
+}
+
+// Validate checks the subject locally.
+func (s *DataDeletionSubject) Validate() error {
+	var v validator
+	if s.SubjectReference == "" && s.IdentityID == "" && s.Email == "" {
+		v.fail("subject_reference", "Must provide subject_reference, identity_id, or email")
+	}
+	if s.Email != "" && !strings.Contains(s.Email, "@") {
+		v.fail("email", "email must be an email address")
+	}
+	return v.err()
+}
+
+// Validate checks the request locally.
+func (r *BiometricConsentRequest) Validate() error {
+	var v validator
+	if r.SubjectReference == "" || r.Jurisdiction == "" {
+		v.fail("subject_reference", "Must provide subject_reference and jurisdiction")
+	}
+	v.nonNegative("retention_days", r.RetentionDays)
+	v.base64("document_base64", r.DocumentBase64)
+	return v.err()
+}
+
+// Validate checks the request locally.
+func (r *StartIdentityClaimRequest) Validate() error {
+	var v validator
+	if r.IdentityID == "" && r.DisplayName == "" {
+		v.fail("identity_id", "Must provide identity_id or display_name")
+	}
+	if r.Email != "" && !strings.Contains(r.Email, "@") {
+		v.fail("email", "email must be an email address")
+	}
+	v.url("return_url", r.ReturnURL)
+	return v.err()
+}
+
+// Validate checks the request locally.
+func (r *ActorPackDownloadRequest) Validate() error {
+	var v validator
+	v.nonNegative("version", r.Version)
+	return v.err()
+}
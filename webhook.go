@@ -0,0 +1,75 @@
+package actorhub
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Webhook event types.
+const (
+	// WebhookEventConsentChanged is sent when an identity owner changes consent settings.
+	WebhookEventConsentChanged = "consent.changed"
+)
+
+var (
+	// ErrInvalidWebhookSignature is returned when a webhook payload does not
+	// match its X-ActorHub-Signature header.
+	ErrInvalidWebhookSignature = errors.New("actorhub: invalid webhook signature")
+
+	// ErrMissingWebhookSecret is returned when a webhook payload is parsed
+	// without a secret, which would let anyone sign an event.
+	ErrMissingWebhookSecret = errors.New("actorhub: webhook secret is required")
+)
+
+// WebhookEvent is an event delivered to a webhook endpoint.
+type WebhookEvent struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt Time            `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// ParseWebhookEvent verifies a webhook payload against the hex-encoded
+// HMAC-SHA256 from the X-ActorHub-Signature header and decodes it.
+//
+// The signature covers only the payload, so a captured delivery verifies
+// again if it is replayed. Handlers should ignore event IDs they have
+// already processed and events whose CreatedAt, which is signed, is older
+// than they are willing to accept.
+func ParseWebhookEvent(payload []byte, signature, secret string) (*WebhookEvent, error) {
+	if secret == "" {
+		return nil, ErrMissingWebhookSecret
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+
+	got, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(got, expected) {
+		return nil, ErrInvalidWebhookSignature
+	}
+
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal webhook event: %w", err)
+	}
+	return &event, nil
+}
+
+// ConsentChange decodes the data of a consent.changed event.
+func (e *WebhookEvent) ConsentChange() (*ConsentChange, error) {
+	if e.Type != WebhookEventConsentChanged {
+		return nil, fmt.Errorf("actorhub: webhook event is %q, not %q", e.Type, WebhookEventConsentChanged)
+	}
+
+	var change ConsentChange
+	if err := json.Unmarshal(e.Data, &change); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal consent change: %w", err)
+	}
+	return &change, nil
+}
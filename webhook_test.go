@@ -0,0 +1,35 @@
+package actorhub
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestParseWebhookEvent(t *testing.T) {
+	payload := []byte(`{"id":"evt_1","type":"consent.changed","data":{}}`)
+	mac := hmac.New(sha256.New, []byte("whsec"))
+	mac.Write(payload)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	event, err := ParseWebhookEvent(payload, signature, "whsec")
+	if err != nil || event.ID != "evt_1" {
+		t.Errorf("ParseWebhookEvent = %+v, %v, want event evt_1", event, err)
+	}
+	if _, err := ParseWebhookEvent(payload, signature, "other"); !errors.Is(err, ErrInvalidWebhookSignature) {
+		t.Errorf("err = %v, want ErrInvalidWebhookSignature", err)
+	}
+}
+
+func TestParseWebhookEventRequiresSecret(t *testing.T) {
+	payload := []byte(`{"id":"evt_1","type":"consent.changed","data":{}}`)
+	mac := hmac.New(sha256.New, nil)
+	mac.Write(payload)
+
+	_, err := ParseWebhookEvent(payload, hex.EncodeToString(mac.Sum(nil)), "")
+	if !errors.Is(err, ErrMissingWebhookSecret) {
+		t.Errorf("err = %v, want ErrMissingWebhookSecret", err)
+	}
+}